@@ -0,0 +1,296 @@
+package renamed
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BatchOptions are options for directory and multi-file batch operations.
+type BatchOptions struct {
+	// Concurrency is the number of files processed in parallel. Defaults to 4.
+	Concurrency int
+
+	// Recursive controls whether RenameDir descends into subdirectories.
+	Recursive bool
+
+	// IncludeGlobs restricts processing to paths matching at least one of
+	// these patterns (matched against the base filename). If empty, all
+	// supported files are included.
+	IncludeGlobs []string
+
+	// ExcludeGlobs skips paths matching any of these patterns (matched
+	// against the base filename).
+	ExcludeGlobs []string
+
+	// DryRun, when true, walks and filters files but does not call the
+	// rename API; OnItem and OnProgress still fire with nil results.
+	DryRun bool
+
+	// OnProgress is called periodically (throttled) with aggregate progress.
+	OnProgress func(BatchProgress)
+
+	// OnItem is called after each file is processed. Returning an error
+	// aborts the remaining batch.
+	OnItem func(path string, result *RenameResult, err error) error
+}
+
+// BatchProgress describes the aggregate state of an in-flight batch operation.
+type BatchProgress struct {
+	// Completed is the number of files processed so far.
+	Completed int
+
+	// Total is the total number of files in the batch.
+	Total int
+
+	// BytesProcessed is the cumulative size of the files renamed
+	// successfully so far. Failed files and DryRun files are not counted.
+	BytesProcessed int64
+
+	// CurrentFile is the path most recently completed.
+	CurrentFile string
+
+	// Errors is the number of files that failed so far.
+	Errors int
+}
+
+// BatchItemResult is the outcome of renaming a single file within a batch.
+type BatchItemResult struct {
+	// Path is the file path that was processed.
+	Path string
+
+	// Result is the suggested rename, nil if the file failed or DryRun was set.
+	Result *RenameResult
+
+	// Err is the error encountered processing this file, if any.
+	Err error
+}
+
+// BatchReport summarizes the outcome of a directory or multi-file batch rename.
+type BatchReport struct {
+	// Total is the number of files considered.
+	Total int
+
+	// Succeeded is the number of files successfully renamed.
+	Succeeded int
+
+	// Failed is the number of files that returned an error.
+	Failed int
+
+	// Items holds the per-file results, in completion order.
+	Items []BatchItemResult
+
+	// Duration is the total wall-clock time spent on the batch.
+	Duration time.Duration
+}
+
+const defaultBatchConcurrency = 4
+const batchProgressInterval = 200 * time.Millisecond
+
+// matchesGlobs reports whether base matches any of the given glob patterns.
+// An empty pattern list matches nothing.
+func matchesGlobs(base string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldIncludeFile applies the IncludeGlobs/ExcludeGlobs filters and the
+// supported mimeTypes list to a single filename.
+func shouldIncludeFile(path string, opts *BatchOptions) bool {
+	base := filepath.Base(path)
+
+	ext := filepath.Ext(base)
+	if _, ok := mimeTypes[strings.ToLower(ext)]; !ok {
+		return false
+	}
+
+	if opts != nil {
+		if len(opts.IncludeGlobs) > 0 && !matchesGlobs(base, opts.IncludeGlobs) {
+			return false
+		}
+		if matchesGlobs(base, opts.ExcludeGlobs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// collectBatchPaths walks dirPath and returns the files eligible for batch
+// processing, honoring opts.Recursive and the include/exclude globs.
+func collectBatchPaths(dirPath string, opts *BatchOptions) ([]string, error) {
+	var paths []string
+
+	err := filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dirPath && (opts == nil || !opts.Recursive) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if shouldIncludeFile(path, opts) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// RenameDir walks dirPath, filters files by supported mime type and the
+// include/exclude globs in opts, and renames the matching files concurrently.
+//
+// Example:
+//
+//	report, err := client.RenameDir(ctx, "./scans", &renamed.BatchOptions{
+//	    Concurrency: 8,
+//	    Recursive:   true,
+//	})
+func (c *Client) RenameDir(ctx context.Context, dirPath string, opts *BatchOptions) (*BatchReport, error) {
+	paths, err := collectBatchPaths(dirPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BatchRename(ctx, paths, opts)
+}
+
+// BatchRename renames each of paths concurrently using a bounded worker pool.
+//
+// Example:
+//
+//	report, err := client.BatchRename(ctx, []string{"a.pdf", "b.pdf"}, &renamed.BatchOptions{
+//	    OnProgress: func(p renamed.BatchProgress) {
+//	        fmt.Printf("%d/%d\n", p.Completed, p.Total)
+//	    },
+//	})
+func (c *Client) BatchRename(ctx context.Context, paths []string, opts *BatchOptions) (*BatchReport, error) {
+	start := time.Now()
+
+	concurrency := defaultBatchConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	report := &BatchReport{Total: len(paths)}
+	if len(paths) == 0 {
+		report.Duration = time.Since(start)
+		return report, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		completed  int
+		errCount   int
+		bytes      int64
+		abortErr   error
+		lastReport time.Time
+		sem        = make(chan struct{}, concurrency)
+		wg         sync.WaitGroup
+	)
+
+	report.Items = make([]BatchItemResult, 0, len(paths))
+
+	recordItem := func(item BatchItemResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		completed++
+		if item.Err != nil {
+			errCount++
+		}
+		if item.Result != nil {
+			bytes += fileSizeOrZero(item.Path)
+		}
+		report.Items = append(report.Items, item)
+
+		if opts != nil && opts.OnProgress != nil {
+			now := time.Now()
+			if completed == len(paths) || now.Sub(lastReport) >= batchProgressInterval {
+				lastReport = now
+				opts.OnProgress(BatchProgress{
+					Completed:      completed,
+					Total:          len(paths),
+					BytesProcessed: bytes,
+					CurrentFile:    item.Path,
+					Errors:         errCount,
+				})
+			}
+		}
+
+		if opts != nil && opts.OnItem != nil {
+			if err := opts.OnItem(item.Path, item.Result, item.Err); err != nil && abortErr == nil {
+				abortErr = err
+				cancel()
+			}
+		}
+	}
+
+	for _, path := range paths {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var result *RenameResult
+			var err error
+			if opts == nil || !opts.DryRun {
+				result, err = c.Rename(ctx, path, nil)
+			}
+
+			recordItem(BatchItemResult{Path: path, Result: result, Err: err})
+		}(path)
+	}
+
+	wg.Wait()
+
+	for _, item := range report.Items {
+		if item.Err == nil {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+	report.Duration = time.Since(start)
+
+	if abortErr != nil {
+		return report, fmt.Errorf("batch aborted by OnItem: %w", abortErr)
+	}
+
+	return report, nil
+}
+
+// fileSizeOrZero returns the size of path in bytes, or 0 if it cannot be stat'd.
+func fileSizeOrZero(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,235 @@
+package renamed
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ConcurrentBatchOptions are options for RenameBatch, ExtractBatch, and
+// PDFSplitBatch. It is a distinct type from BatchOptions (used by
+// RenameDir/BatchRename) because the two batch APIs support different
+// controls; sharing one struct would let a field meant for one silently
+// no-op on the other.
+type ConcurrentBatchOptions struct {
+	// Concurrency is the number of files processed in parallel. Defaults
+	// to runtime.GOMAXPROCS(0).
+	Concurrency int
+
+	// StopOnError cancels remaining work as soon as any file fails.
+	StopOnError bool
+
+	// PerFileTimeout bounds how long a single file may take. Zero means no
+	// per-file limit beyond ctx.
+	PerFileTimeout time.Duration
+
+	// ProgressCallback is invoked after each file completes. result is
+	// only populated for RenameBatch; it is always nil for ExtractBatch
+	// and PDFSplitBatch, whose typed per-file results are available on the
+	// returned BatchResult instead.
+	ProgressCallback func(done, total int, current string, result *RenameResult, err error)
+}
+
+// BatchItem is the outcome of processing a single file within a
+// RenameBatch, ExtractBatch, or PDFSplitBatch call.
+type BatchItem[T any] struct {
+	// Path is the file path that was processed.
+	Path string
+
+	// Result is the per-file result, the zero value if Err is non-nil.
+	Result T
+
+	// Err is the error encountered processing this file, if any. Concrete
+	// error types such as *RateLimitError and *InsufficientCreditsError are
+	// preserved, so callers can type-switch or errors.As on it.
+	Err error
+}
+
+// BatchResult summarizes the outcome of a concurrent RenameBatch,
+// ExtractBatch, or PDFSplitBatch call.
+type BatchResult[T any] struct {
+	// Total is the number of files considered.
+	Total int
+
+	// Succeeded is the number of files processed without error.
+	Succeeded int
+
+	// Failed is the number of files that returned an error.
+	Failed int
+
+	// Items holds the per-file results, in completion order.
+	Items []BatchItem[T]
+
+	// Duration is the total wall-clock time spent on the batch.
+	Duration time.Duration
+}
+
+// rateLimitGate coalesces 429 backoff across concurrent batch workers: once
+// any worker observes a RateLimitError, every worker pauses before its next
+// request until the same cooldown elapses, rather than each one retrying
+// independently and re-tripping the limit.
+type rateLimitGate struct {
+	mu         sync.Mutex
+	pauseUntil time.Time
+}
+
+// wait blocks until the gate's cooldown (if any) has elapsed or ctx is done.
+func (g *rateLimitGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	until := g.pauseUntil
+	g.mu.Unlock()
+	return sleepCtx(ctx, time.Until(until))
+}
+
+// trip extends the gate's cooldown to at least d from now.
+func (g *rateLimitGate) trip(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until := time.Now().Add(d); until.After(g.pauseUntil) {
+		g.pauseUntil = until
+	}
+}
+
+// runConcurrentBatch processes paths concurrently via a bounded worker pool,
+// calling process for each. It respects ctx cancellation (including
+// opts.StopOnError aborting the rest of the batch), honors
+// opts.PerFileTimeout, and coalesces 429 backoff across workers via a shared
+// rateLimitGate.
+func runConcurrentBatch[T any](ctx context.Context, paths []string, opts *ConcurrentBatchOptions, process func(ctx context.Context, path string) (T, error)) (*BatchResult[T], error) {
+	start := time.Now()
+
+	concurrency := runtime.GOMAXPROCS(0)
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	result := &BatchResult[T]{Total: len(paths)}
+	if len(paths) == 0 {
+		result.Duration = time.Since(start)
+		return result, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu   sync.Mutex
+		gate rateLimitGate
+		sem  = make(chan struct{}, concurrency)
+		wg   sync.WaitGroup
+		done int
+	)
+
+	result.Items = make([]BatchItem[T], 0, len(paths))
+
+	recordItem := func(item BatchItem[T]) {
+		mu.Lock()
+		done++
+		n := done
+		result.Items = append(result.Items, item)
+		if item.Err != nil && opts != nil && opts.StopOnError {
+			cancel()
+		}
+		mu.Unlock()
+
+		if opts != nil && opts.ProgressCallback != nil {
+			renameResult, _ := any(item.Result).(*RenameResult)
+			opts.ProgressCallback(n, len(paths), item.Path, renameResult, item.Err)
+		}
+	}
+
+	for _, path := range paths {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := gate.wait(ctx); err != nil {
+				var zero T
+				recordItem(BatchItem[T]{Path: path, Result: zero, Err: err})
+				return
+			}
+
+			itemCtx := ctx
+			if opts != nil && opts.PerFileTimeout > 0 {
+				var itemCancel context.CancelFunc
+				itemCtx, itemCancel = context.WithTimeout(ctx, opts.PerFileTimeout)
+				defer itemCancel()
+			}
+
+			itemResult, err := process(itemCtx, path)
+			if rlErr, ok := err.(*RateLimitError); ok {
+				gate.trip(time.Duration(rlErr.RetryAfter) * time.Second)
+			}
+
+			recordItem(BatchItem[T]{Path: path, Result: itemResult, Err: err})
+		}(path)
+	}
+
+	wg.Wait()
+
+	for _, item := range result.Items {
+		if item.Err == nil {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	result.Duration = time.Since(start)
+
+	return result, nil
+}
+
+// RenameBatch renames each of paths concurrently using a bounded worker
+// pool (see ConcurrentBatchOptions.Concurrency, default GOMAXPROCS).
+//
+// Example:
+//
+//	result, err := client.RenameBatch(ctx, []string{"a.pdf", "b.pdf"}, &renamed.ConcurrentBatchOptions{
+//	    StopOnError: true,
+//	})
+func (c *Client) RenameBatch(ctx context.Context, paths []string, opts *ConcurrentBatchOptions) (*BatchResult[*RenameResult], error) {
+	rc := c.withSerializedReporter()
+	return runConcurrentBatch(ctx, paths, opts, func(ctx context.Context, path string) (*RenameResult, error) {
+		return rc.Rename(ctx, path, nil)
+	})
+}
+
+// ExtractBatch extracts structured data from each of paths concurrently
+// using a bounded worker pool. Every file is extracted with the same
+// extractOpts.
+func (c *Client) ExtractBatch(ctx context.Context, paths []string, extractOpts *ExtractOptions, opts *ConcurrentBatchOptions) (*BatchResult[*ExtractResult], error) {
+	rc := c.withSerializedReporter()
+	return runConcurrentBatch(ctx, paths, opts, func(ctx context.Context, path string) (*ExtractResult, error) {
+		return rc.Extract(ctx, path, extractOpts)
+	})
+}
+
+// PDFSplitBatch submits each of paths for splitting concurrently using a
+// bounded worker pool, returning the in-flight AsyncJob for every file.
+// Callers are responsible for waiting on each job, e.g. via AsyncJob.Wait.
+func (c *Client) PDFSplitBatch(ctx context.Context, paths []string, splitOpts *PdfSplitOptions, opts *ConcurrentBatchOptions) (*BatchResult[*AsyncJob], error) {
+	rc := c.withSerializedReporter()
+	return runConcurrentBatch(ctx, paths, opts, func(ctx context.Context, path string) (*AsyncJob, error) {
+		job, err := rc.PDFSplit(ctx, path, splitOpts)
+		if job != nil {
+			// Hand back jobs bound to c, not the batch-only shadow client,
+			// whose serializing Reporter expects every Finish to follow a
+			// StartUpload or StartDownload.
+			job.client = c
+		}
+		return job, err
+	})
+}
@@ -0,0 +1,169 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// writeBatchFixtures creates the given files (with dummy content) under a
+// fresh temp dir and returns their full paths.
+func writeBatchFixtures(t *testing.T, names ...string) []string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make([]string, len(names))
+	for i, name := range names {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("fake"), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+		paths[i] = path
+	}
+	return paths
+}
+
+func TestRenameBatch(t *testing.T) {
+	t.Run("renames files concurrently and reports per-file progress", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "renamed.pdf", Confidence: 0.9})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		paths := writeBatchFixtures(t, "a.pdf", "b.pdf", "c.pdf")
+
+		var progressCalls int32
+		result, err := client.RenameBatch(context.Background(), paths, &ConcurrentBatchOptions{
+			Concurrency: 2,
+			ProgressCallback: func(done, total int, current string, result *RenameResult, err error) {
+				atomic.AddInt32(&progressCalls, 1)
+				if result == nil || result.SuggestedFilename != "renamed.pdf" {
+					t.Errorf("expected the per-file result to be populated, got %+v", result)
+				}
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Total != 3 || result.Succeeded != 3 || result.Failed != 0 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 API calls, got %d", calls)
+		}
+		if progressCalls != 3 {
+			t.Errorf("expected 3 progress callbacks, got %d", progressCalls)
+		}
+	})
+
+	t.Run("StopOnError cancels remaining work", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]any{"error": "bad file"})
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "renamed.pdf"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		paths := writeBatchFixtures(t, "a.pdf", "b.pdf", "c.pdf", "d.pdf")
+
+		result, err := client.RenameBatch(context.Background(), paths, &ConcurrentBatchOptions{
+			Concurrency: 1,
+			StopOnError: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Failed == 0 {
+			t.Error("expected at least one failure")
+		}
+		if len(result.Items) == 4 {
+			t.Error("expected StopOnError to short-circuit the remaining files")
+		}
+	})
+
+	t.Run("preserves typed errors per file", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusPaymentRequired)
+			json.NewEncoder(w).Encode(map[string]any{"error": "insufficient credits"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		paths := writeBatchFixtures(t, "a.pdf")
+
+		result, err := client.RenameBatch(context.Background(), paths, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Items) != 1 {
+			t.Fatalf("expected 1 item, got %d", len(result.Items))
+		}
+		if _, ok := result.Items[0].Err.(*InsufficientCreditsError); !ok {
+			t.Errorf("expected *InsufficientCreditsError, got %T: %v", result.Items[0].Err, result.Items[0].Err)
+		}
+	})
+}
+
+func TestExtractBatch(t *testing.T) {
+	t.Run("extracts from files concurrently", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(ExtractResult{Data: map[string]any{"total": "10.00"}, Confidence: 0.8})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		paths := writeBatchFixtures(t, "a.pdf", "b.pdf")
+
+		result, err := client.ExtractBatch(context.Background(), paths, &ExtractOptions{Prompt: "grab the total"}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Succeeded != 2 {
+			t.Errorf("expected 2 successes, got %d", result.Succeeded)
+		}
+		for _, item := range result.Items {
+			if item.Result == nil || item.Result.Data["total"] != "10.00" {
+				t.Errorf("unexpected item result: %+v", item)
+			}
+		}
+	})
+}
+
+func TestPDFSplitBatch(t *testing.T) {
+	t.Run("submits each file for splitting concurrently", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(pdfSplitResponse{JobID: "job1", StatusURL: "/status/job1"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		paths := writeBatchFixtures(t, "a.pdf", "b.pdf")
+
+		result, err := client.PDFSplitBatch(context.Background(), paths, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Succeeded != 2 {
+			t.Errorf("expected 2 successes, got %d", result.Succeeded)
+		}
+		for _, item := range result.Items {
+			if item.Result == nil || item.Result.jobID != "job1" {
+				t.Errorf("unexpected item result: %+v", item)
+			}
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatchRename(t *testing.T) {
+	t.Run("renames files concurrently and reports totals", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{
+				SuggestedFilename: "renamed.pdf",
+				Confidence:        0.9,
+			})
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		for _, name := range []string{"a.pdf", "b.pdf", "c.txt"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("fake"), 0644); err != nil {
+				t.Fatalf("failed to write fixture: %v", err)
+			}
+		}
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		report, err := client.RenameDir(context.Background(), dir, &BatchOptions{Concurrency: 2})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if report.Total != 2 {
+			t.Errorf("expected 2 eligible files (pdf only), got %d", report.Total)
+		}
+		if report.Succeeded != 2 {
+			t.Errorf("expected 2 successes, got %d", report.Succeeded)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 API calls, got %d", calls)
+		}
+	})
+
+	t.Run("OnItem error aborts the remaining batch", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "renamed.pdf"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		dir := t.TempDir()
+		for _, name := range []string{"a.pdf", "b.pdf"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("fake"), 0644); err != nil {
+				t.Fatalf("failed to write fixture: %v", err)
+			}
+		}
+
+		_, err := client.RenameDir(context.Background(), dir, &BatchOptions{
+			Concurrency: 1,
+			OnItem: func(path string, result *RenameResult, err error) error {
+				return context.Canceled
+			},
+		})
+		if err == nil {
+			t.Error("expected batch to abort with an error")
+		}
+	})
+
+	t.Run("DryRun skips API calls", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		report, err := client.BatchRename(context.Background(), []string{"a.pdf", "b.pdf"}, &BatchOptions{DryRun: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("expected no API calls in dry run, got %d", calls)
+		}
+		if report.Succeeded != 2 {
+			t.Errorf("expected 2 dry-run successes, got %d", report.Succeeded)
+		}
+	})
+}
+
+func TestMatchesGlobs(t *testing.T) {
+	if !matchesGlobs("invoice.pdf", []string{"*.pdf"}) {
+		t.Error("expected *.pdf to match invoice.pdf")
+	}
+	if matchesGlobs("invoice.pdf", []string{"*.png"}) {
+		t.Error("expected *.png not to match invoice.pdf")
+	}
+	if matchesGlobs("invoice.pdf", nil) {
+		t.Error("expected empty pattern list to match nothing")
+	}
+}
@@ -0,0 +1,213 @@
+package renamed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const defaultCacheTTL = 24 * time.Hour
+
+// Cache is a pluggable store for idempotent Rename/Extract results, keyed by
+// the content hash of the uploaded file plus its request options.
+type Cache interface {
+	// Get returns the cached value for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value under key with the given time-to-live. A ttl of zero
+	// means the entry never expires.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the cached value for key, if any.
+	Delete(key string) error
+}
+
+// WithCache sets the cache used for idempotent Rename/Extract results. Re-
+// running the same file with the same options returns the cached result
+// instead of making an API call.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL sets the default time-to-live for cached results. Defaults to
+// 24 hours.
+func WithCacheTTL(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cacheTTL = d
+	}
+}
+
+func (c *Client) cacheTTLOrDefault() time.Duration {
+	if c.cacheTTL > 0 {
+		return c.cacheTTL
+	}
+	return defaultCacheTTL
+}
+
+// cacheKey returns a stable cache key combining the SHA-256 of content with
+// a canonical hash of the request options, scoped by operation.
+func cacheKey(operation string, content []byte, optionsKey string) string {
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("%s:%s:%s", operation, hex.EncodeToString(sum[:]), optionsKey)
+}
+
+// canonicalRenameOptionsKey returns a stable hash of the rename options that
+// affect the result, for use in the cache key.
+func canonicalRenameOptionsKey(opts *RenameOptions) string {
+	if opts == nil {
+		return ""
+	}
+	return hashJSON(struct {
+		Template string `json:"template"`
+	}{Template: opts.Template})
+}
+
+// canonicalExtractOptionsKey returns a stable hash of the extract options
+// that affect the result, for use in the cache key.
+func canonicalExtractOptionsKey(opts *ExtractOptions) string {
+	if opts == nil {
+		return ""
+	}
+	return hashJSON(struct {
+		Schema map[string]any `json:"schema"`
+		Prompt string         `json:"prompt"`
+	}{Schema: opts.Schema, Prompt: opts.Prompt})
+}
+
+// hashJSON returns the hex-encoded SHA-256 of v's canonical JSON encoding.
+// encoding/json sorts map keys, so the result is stable across calls.
+func hashJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// MemoryCache is an in-process Cache backed by a map, safe for concurrent use.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates an empty in-process cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	m.entries[key] = memoryCacheEntry{value: value, expiresAt: expiresAt}
+	return nil
+}
+
+// Delete implements Cache.
+func (m *MemoryCache) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// FileCache is a Cache backed by one file per key in a directory.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache creates a file-backed cache rooted at dir. The directory is
+// created lazily on the first Set call.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+type fileCacheEntry struct {
+	Value     []byte    `json:"value"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Cache.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(f.path(key))
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Set implements Cache.
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) error {
+	if err := os.MkdirAll(f.dir, 0755); err != nil {
+		return err
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	data, err := json.Marshal(fileCacheEntry{Value: value, ExpiresAt: expiresAt})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(f.path(key), data, 0644)
+}
+
+// Delete implements Cache.
+func (f *FileCache) Delete(key string) error {
+	err := os.Remove(f.path(key))
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
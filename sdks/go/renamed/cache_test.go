@@ -0,0 +1,135 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRenameCache(t *testing.T) {
+	t.Run("serves repeated renames from the cache", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf", Confidence: 0.9})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithCache(NewMemoryCache()))
+
+		for i := 0; i < 3; i++ {
+			result, err := client.RenameReader(context.Background(), &mockReader{data: []byte("same content")}, "a.pdf", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.SuggestedFilename != "invoice.pdf" {
+				t.Errorf("unexpected result: %+v", result)
+			}
+		}
+
+		if calls != 1 {
+			t.Errorf("expected exactly 1 API call, got %d", calls)
+		}
+	})
+
+	t.Run("NoCache bypasses the cache", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithCache(NewMemoryCache()))
+
+		for i := 0; i < 2; i++ {
+			_, err := client.RenameReader(context.Background(), &mockReader{data: []byte("same content")}, "a.pdf", &RenameOptions{NoCache: true})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 API calls with NoCache, got %d", calls)
+		}
+	})
+
+	t.Run("different templates produce different cache keys", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithCache(NewMemoryCache()))
+
+		_, _ = client.RenameReader(context.Background(), &mockReader{data: []byte("same content")}, "a.pdf", &RenameOptions{Template: "one"})
+		_, _ = client.RenameReader(context.Background(), &mockReader{data: []byte("same content")}, "a.pdf", &RenameOptions{Template: "two"})
+
+		if calls != 2 {
+			t.Errorf("expected 2 API calls for different templates, got %d", calls)
+		}
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Run("expires entries by ttl", func(t *testing.T) {
+		cache := NewMemoryCache()
+		if err := cache.Set("k", []byte("v"), time.Millisecond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		if _, ok := cache.Get("k"); ok {
+			t.Error("expected expired entry to be absent")
+		}
+	})
+
+	t.Run("delete removes entries", func(t *testing.T) {
+		cache := NewMemoryCache()
+		_ = cache.Set("k", []byte("v"), 0)
+		_ = cache.Delete("k")
+
+		if _, ok := cache.Get("k"); ok {
+			t.Error("expected deleted entry to be absent")
+		}
+	})
+}
+
+func TestFileCache(t *testing.T) {
+	t.Run("persists entries to disk", func(t *testing.T) {
+		dir := t.TempDir()
+		cache := NewFileCache(filepath.Join(dir, "cache"))
+
+		if err := cache.Set("k", []byte("v"), 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		value, ok := cache.Get("k")
+		if !ok {
+			t.Fatal("expected cached value to be present")
+		}
+		if string(value) != "v" {
+			t.Errorf("expected v, got %s", value)
+		}
+	})
+
+	t.Run("expires entries by ttl", func(t *testing.T) {
+		dir := t.TempDir()
+		cache := NewFileCache(dir)
+
+		_ = cache.Set("k", []byte("v"), time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+
+		if _, ok := cache.Get("k"); ok {
+			t.Error("expected expired entry to be absent")
+		}
+	})
+}
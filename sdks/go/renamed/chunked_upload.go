@@ -0,0 +1,324 @@
+package renamed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	defaultChunkSize          int64 = 8 << 20   // 8 MB
+	defaultResumableThreshold int64 = 100 << 20 // 100 MB
+)
+
+// UploadProgress is called with the number of bytes sent so far and the
+// total size of the upload as it progresses.
+type UploadProgress func(sent, total int64)
+
+// WithChunkSize sets the chunk size used for resumable uploads. Defaults to 8 MB.
+func WithChunkSize(sz int64) ClientOption {
+	return func(c *Client) {
+		c.chunkSize = sz
+	}
+}
+
+// WithResumableThreshold sets the file size above which Rename and PDFSplit
+// automatically switch from a single-shot multipart upload to a resumable,
+// chunked upload. Defaults to 100 MB.
+func WithResumableThreshold(sz int64) ClientOption {
+	return func(c *Client) {
+		c.resumableThreshold = sz
+	}
+}
+
+// WithChunkedUploads is an alias for WithResumableThreshold, for callers who
+// think in terms of "switch to chunked uploads above this size" rather than
+// "resumable threshold". It configures the same client field.
+func WithChunkedUploads(threshold int64) ClientOption {
+	return WithResumableThreshold(threshold)
+}
+
+// WithUploadProgress sets a client-wide default upload progress callback,
+// used for any call whose Options don't set their own OnUploadProgress.
+func WithUploadProgress(cb UploadProgress) ClientOption {
+	return func(c *Client) {
+		c.uploadProgress = cb
+	}
+}
+
+// effectiveProgress returns perCall if set, falling back to the client-wide
+// default configured via WithUploadProgress.
+func (c *Client) effectiveProgress(perCall UploadProgress) UploadProgress {
+	if perCall != nil {
+		return perCall
+	}
+	return c.uploadProgress
+}
+
+func (c *Client) chunkSizeOrDefault() int64 {
+	if c.chunkSize > 0 {
+		return c.chunkSize
+	}
+	return defaultChunkSize
+}
+
+func (c *Client) resumableThresholdOrDefault() int64 {
+	if c.resumableThreshold > 0 {
+		return c.resumableThreshold
+	}
+	return defaultResumableThreshold
+}
+
+// uploadSession tracks a resumable upload's progress on the server.
+type uploadSession struct {
+	SessionToken string `json:"sessionToken"`
+	TargetPath   string `json:"targetPath"`
+	Offset       int64  `json:"offset"`
+	Size         int64  `json:"size"`
+}
+
+// startUploadSession initiates a resumable upload and returns the session
+// token the server will use to track progress. targetPath is the endpoint
+// (e.g. "/rename") the assembled file is routed to once the upload completes.
+func (c *Client) startUploadSession(ctx context.Context, targetPath, filename string, size int64, fields map[string]string) (*uploadSession, error) {
+	payload := map[string]any{
+		"filename":   filename,
+		"size":       size,
+		"targetPath": targetPath,
+		"fields":     fields,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.request(ctx, http.MethodPost, "/uploads", bytes.NewReader(body), "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	var session uploadSession
+	if err := json.Unmarshal(respBody, &session); err != nil {
+		return nil, err
+	}
+	session.Size = size
+	session.TargetPath = targetPath
+
+	return &session, nil
+}
+
+// patchChunk uploads a single chunk of a resumable upload using a
+// Content-Range header, matching the Docker registry blob-upload style.
+func (c *Client) patchChunk(ctx context.Context, sessionToken string, data []byte, start, total int64) ([]byte, error) {
+	end := start + int64(len(data)) - 1
+	url := c.buildURL(fmt.Sprintf("/uploads/%s", sessionToken))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, total))
+
+	resp, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, NewNetworkError(err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var payload map[string]any
+		_ = json.Unmarshal(respBody, &payload)
+		return nil, ErrorFromHTTPStatus(resp, payload, respBody)
+	}
+
+	c.logf("PATCH /uploads/%s bytes %d-%d/%d -> %d", sessionToken, start, end, total, resp.StatusCode)
+
+	return respBody, nil
+}
+
+// completeUpload tells the server all chunks have been received and returns
+// the final result of running the assembled file through session.TargetPath.
+func (c *Client) completeUpload(ctx context.Context, sessionToken string) ([]byte, error) {
+	return c.request(ctx, http.MethodPost, fmt.Sprintf("/uploads/%s/complete", sessionToken), nil, "")
+}
+
+// sendChunks uploads the remaining bytes of r, starting at session.Offset,
+// retrying only the failed chunk (via the client's existing retry policy)
+// rather than restarting the whole upload.
+func (c *Client) sendChunks(ctx context.Context, session *uploadSession, r io.ReaderAt, onProgress UploadProgress) ([]byte, error) {
+	chunkSize := c.chunkSizeOrDefault()
+	offset := session.Offset
+
+	for offset < session.Size {
+		n := chunkSize
+		if remaining := session.Size - offset; remaining < n {
+			n = remaining
+		}
+
+		buf := make([]byte, n)
+		if _, err := r.ReadAt(buf, offset); err != nil && err != io.EOF {
+			return nil, NewNetworkError(err)
+		}
+
+		if _, err := c.patchChunk(ctx, session.SessionToken, buf, offset, session.Size); err != nil {
+			return nil, err
+		}
+
+		offset += n
+		if onProgress != nil {
+			onProgress(offset, session.Size)
+		}
+	}
+
+	return c.completeUpload(ctx, session.SessionToken)
+}
+
+// uploadChunked performs a full resumable upload: starting a session,
+// streaming chunks via patchChunk, and completing it once all bytes have
+// been acknowledged by the server.
+func (c *Client) uploadChunked(ctx context.Context, targetPath, filename string, r io.ReaderAt, size int64, fields map[string]string, onProgress UploadProgress) ([]byte, error) {
+	session, err := c.startUploadSession(ctx, targetPath, filename, size, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendChunks(ctx, session, r, onProgress)
+}
+
+// ResumeUpload resumes a previously started chunked upload identified by
+// sessionToken, continuing from the offset the server last acknowledged,
+// and returns the rename result once the upload completes.
+//
+// Example:
+//
+//	result, err := client.ResumeUpload(ctx, sessionToken, file)
+func (c *Client) ResumeUpload(ctx context.Context, sessionToken string, r io.ReaderAt) (*RenameResult, error) {
+	respBody, err := c.request(ctx, http.MethodGet, fmt.Sprintf("/uploads/%s", sessionToken), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var session uploadSession
+	if err := json.Unmarshal(respBody, &session); err != nil {
+		return nil, err
+	}
+	session.SessionToken = sessionToken
+
+	finalBody, err := c.sendChunks(ctx, &session, r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RenameResult
+	if err := json.Unmarshal(finalBody, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ChunkedUploader starts resumable uploads against a single endpoint (e.g.
+// "/rename" or "/extract"), handing back an Upload that the caller drives
+// chunk by chunk. Most callers should prefer Rename, PDFSplit, or Extract,
+// which switch to chunked uploads automatically above WithChunkedUploads;
+// ChunkedUploader is for callers that need to control chunking themselves,
+// for example to upload from a source that doesn't fit io.ReaderAt.
+type ChunkedUploader struct {
+	client     *Client
+	targetPath string
+	fields     map[string]string
+}
+
+// NewChunkedUploader creates a ChunkedUploader that routes completed uploads
+// to targetPath (e.g. "/rename"). fields are extra form fields to send with
+// the upload, such as a rename template or extract schema.
+func NewChunkedUploader(client *Client, targetPath string, fields map[string]string) *ChunkedUploader {
+	return &ChunkedUploader{client: client, targetPath: targetPath, fields: fields}
+}
+
+// Start initiates a new resumable upload session for a file of the given
+// size and returns an Upload used to stream its chunks.
+func (u *ChunkedUploader) Start(ctx context.Context, filename string, size int64) (*Upload, error) {
+	session, err := u.client.startUploadSession(ctx, u.targetPath, filename, size, u.fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Upload{client: u.client, session: session}, nil
+}
+
+// Upload tracks a single in-progress resumable upload, acknowledging the
+// server's last-received offset so a network blip partway through a large
+// file resumes instead of restarting from zero.
+type Upload struct {
+	client  *Client
+	session *uploadSession
+}
+
+// ID returns the session token identifying this upload, for later use with
+// Resume from another process or after a crash.
+func (up *Upload) ID() string {
+	return up.session.SessionToken
+}
+
+// Offset returns the last byte offset the server has acknowledged.
+func (up *Upload) Offset() int64 {
+	return up.session.Offset
+}
+
+// WriteChunk uploads a single chunk of data starting at offset. The caller
+// is responsible for sending chunks in order; on success, Offset advances
+// past the written bytes.
+func (up *Upload) WriteChunk(ctx context.Context, offset int64, data []byte) error {
+	if _, err := up.client.patchChunk(ctx, up.session.SessionToken, data, offset, up.session.Size); err != nil {
+		return err
+	}
+	up.session.Offset = offset + int64(len(data))
+	return nil
+}
+
+// Resume reattaches to a previously started upload identified by uploadID,
+// refetching the last offset the server acknowledged so WriteChunk can pick
+// up where it left off.
+func (up *Upload) Resume(ctx context.Context, uploadID string) error {
+	respBody, err := up.client.request(ctx, http.MethodGet, fmt.Sprintf("/uploads/%s", uploadID), nil, "")
+	if err != nil {
+		return err
+	}
+
+	var session uploadSession
+	if err := json.Unmarshal(respBody, &session); err != nil {
+		return err
+	}
+	session.SessionToken = uploadID
+
+	up.session = &session
+	return nil
+}
+
+// Complete tells the server all chunks have been received and returns the
+// raw JSON result of running the assembled file through the uploader's
+// targetPath. Unlike Rename/PDFSplit/Extract, which each know their own
+// result type, ChunkedUploader can target any endpoint (see
+// NewChunkedUploader), so Complete can't pick a concrete type to unmarshal
+// into; callers should json.Unmarshal the returned bytes into the type
+// matching targetPath, e.g. RenameResult for "/rename" or ExtractResult for
+// "/extract".
+func (up *Upload) Complete(ctx context.Context) (json.RawMessage, error) {
+	respBody, err := up.client.completeUpload(ctx, up.session.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(respBody), nil
+}
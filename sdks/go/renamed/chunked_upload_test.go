@@ -0,0 +1,214 @@
+package renamed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRenameChunkedUpload(t *testing.T) {
+	t.Run("switches to chunked upload above the resumable threshold", func(t *testing.T) {
+		const fileSize = 30
+		var gotChunks [][2]int64
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/uploads", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(uploadSession{SessionToken: "sess1", Offset: 0, Size: fileSize})
+		})
+		mux.HandleFunc("/uploads/sess1", func(w http.ResponseWriter, r *http.Request) {
+			var start, end, total int64
+			fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total)
+			gotChunks = append(gotChunks, [2]int64{start, end})
+			w.WriteHeader(http.StatusAccepted)
+		})
+		mux.HandleFunc("/uploads/sess1/complete", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "big.pdf", Confidence: 0.8})
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithResumableThreshold(10), WithChunkSize(12))
+
+		var progressed []int64
+		result, err := client.RenameReader(context.Background(), strings.NewReader(strings.Repeat("x", fileSize)), "big.pdf", &RenameOptions{
+			OnUploadProgress: func(sent, total int64) {
+				progressed = append(progressed, sent)
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.SuggestedFilename != "big.pdf" {
+			t.Errorf("expected suggested filename big.pdf, got %s", result.SuggestedFilename)
+		}
+		if len(gotChunks) != 3 {
+			t.Errorf("expected 3 chunks (12+12+6), got %d: %v", len(gotChunks), gotChunks)
+		}
+		if len(progressed) == 0 || progressed[len(progressed)-1] != fileSize {
+			t.Errorf("expected final progress to report full size, got %v", progressed)
+		}
+	})
+}
+
+func TestResumeUpload(t *testing.T) {
+	t.Run("resumes from the server-reported offset", func(t *testing.T) {
+		var gotChunks [][2]int64
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/uploads/sess2", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(uploadSession{SessionToken: "sess2", Offset: 5, Size: 10})
+				return
+			}
+			var start, end, total int64
+			fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total)
+			gotChunks = append(gotChunks, [2]int64{start, end})
+			w.WriteHeader(http.StatusAccepted)
+		})
+		mux.HandleFunc("/uploads/sess2/complete", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "resumed.pdf"})
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		result, err := client.ResumeUpload(context.Background(), "sess2", bytes.NewReader([]byte("0123456789")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.SuggestedFilename != "resumed.pdf" {
+			t.Errorf("expected suggested filename resumed.pdf, got %s", result.SuggestedFilename)
+		}
+		if len(gotChunks) != 1 || gotChunks[0][0] != 5 {
+			t.Errorf("expected a single chunk starting at offset 5, got %v", gotChunks)
+		}
+	})
+}
+
+func TestExtractChunkedUpload(t *testing.T) {
+	t.Run("switches to chunked upload above the resumable threshold", func(t *testing.T) {
+		const fileSize = 20
+		var gotChunks int
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/uploads", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(uploadSession{SessionToken: "sess3", Offset: 0, Size: fileSize})
+		})
+		mux.HandleFunc("/uploads/sess3", func(w http.ResponseWriter, r *http.Request) {
+			gotChunks++
+			w.WriteHeader(http.StatusAccepted)
+		})
+		mux.HandleFunc("/uploads/sess3/complete", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(ExtractResult{Data: map[string]any{"total": "42.00"}, Confidence: 0.7})
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithChunkedUploads(10), WithChunkSize(8))
+
+		result, err := client.ExtractReader(context.Background(), strings.NewReader(strings.Repeat("y", fileSize)), "invoice.pdf", &ExtractOptions{
+			Prompt: "grab the total",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Data["total"] != "42.00" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if gotChunks != 3 {
+			t.Errorf("expected 3 chunks (8+8+4), got %d", gotChunks)
+		}
+	})
+}
+
+func TestChunkedUploader(t *testing.T) {
+	t.Run("drives an upload chunk by chunk and completes it", func(t *testing.T) {
+		var gotChunks [][2]int64
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/uploads", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(uploadSession{SessionToken: "sess4", Offset: 0, Size: 20})
+		})
+		mux.HandleFunc("/uploads/sess4", func(w http.ResponseWriter, r *http.Request) {
+			var start, end, total int64
+			fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total)
+			gotChunks = append(gotChunks, [2]int64{start, end})
+			w.WriteHeader(http.StatusAccepted)
+		})
+		mux.HandleFunc("/uploads/sess4/complete", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "manual.pdf"})
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		uploader := NewChunkedUploader(client, "/rename", nil)
+
+		upload, err := uploader.Start(context.Background(), "manual.pdf", 20)
+		if err != nil {
+			t.Fatalf("unexpected error starting upload: %v", err)
+		}
+		if upload.ID() != "sess4" {
+			t.Errorf("expected upload ID sess4, got %s", upload.ID())
+		}
+
+		if err := upload.WriteChunk(context.Background(), 0, bytes.Repeat([]byte("a"), 10)); err != nil {
+			t.Fatalf("unexpected error writing first chunk: %v", err)
+		}
+		if upload.Offset() != 10 {
+			t.Errorf("expected offset 10 after first chunk, got %d", upload.Offset())
+		}
+		if err := upload.WriteChunk(context.Background(), 10, bytes.Repeat([]byte("b"), 10)); err != nil {
+			t.Fatalf("unexpected error writing second chunk: %v", err)
+		}
+
+		respBody, err := upload.Complete(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error completing upload: %v", err)
+		}
+		var result RenameResult
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			t.Fatalf("unexpected error unmarshaling result: %v", err)
+		}
+		if result.SuggestedFilename != "manual.pdf" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if len(gotChunks) != 2 || gotChunks[0][0] != 0 || gotChunks[1][0] != 10 {
+			t.Errorf("expected chunks at offsets 0 and 10, got %v", gotChunks)
+		}
+	})
+
+	t.Run("resume reattaches at the server-reported offset", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/uploads/sess5", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(uploadSession{SessionToken: "sess5", Offset: 15, Size: 20})
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		upload := &Upload{client: client}
+
+		if err := upload.Resume(context.Background(), "sess5"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if upload.Offset() != 15 {
+			t.Errorf("expected resumed offset 15, got %d", upload.Offset())
+		}
+	})
+}
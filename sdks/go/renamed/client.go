@@ -82,14 +82,39 @@ func WithLogger(logger Logger) ClientOption {
 	}
 }
 
+// WithWebhook configures a webhook URL and signing secret that the client
+// forwards to async endpoints (currently PDFSplit) instead of requiring
+// callers to poll AsyncJob.Wait. The server signs each delivery to url
+// using secret; verify deliveries with the renamed/webhook package.
+func WithWebhook(url, secret string) ClientOption {
+	return func(c *Client) {
+		c.webhookURL = url
+		c.webhookSecret = secret
+	}
+}
+
 // Client is the renamed.to API client.
 type Client struct {
-	apiKey     string
-	baseURL    string
-	timeout    time.Duration
-	maxRetries int
-	httpClient *http.Client
-	logger     Logger
+	apiKey             string
+	baseURL            string
+	timeout            time.Duration
+	maxRetries         int
+	httpClient         *http.Client
+	logger             Logger
+	chunkSize          int64
+	resumableThreshold int64
+	cache              Cache
+	cacheTTL           time.Duration
+	webhookURL         string
+	webhookSecret      string
+	backoffBase        time.Duration
+	backoffCap         time.Duration
+	rateLimiter        *tokenBucket
+	breaker            *circuitBreaker
+	onRetry            OnRetryFunc
+	uploadProgress     UploadProgress
+	retryPolicy        *RetryPolicy
+	reporter           Reporter
 }
 
 // logf logs a message if debug logging is enabled.
@@ -151,6 +176,10 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		}
 	}
 
+	if c.breaker == nil {
+		c.breaker = newCircuitBreaker(defaultCircuitThreshold, defaultCircuitWindow, defaultCircuitCooldown)
+	}
+
 	return c
 }
 
@@ -172,25 +201,117 @@ func getMimeType(filename string) string {
 	return "application/octet-stream"
 }
 
+// doRequest sends req, transparently handling rate limiting, retries, and
+// circuit breaking:
+//
+//   - the circuit breaker fast-fails with a CircuitOpenError before every
+//     attempt, including retries, if it's open from prior consecutive
+//     upstream failures (including ones recorded earlier in this same
+//     retry loop);
+//   - the client-side rate limiter (if configured with WithRateLimit) is
+//     waited on before every attempt, including retries;
+//   - a 429 response sleeps for its Retry-After duration and retries
+//     without counting against maxRetries, since the server is asking for
+//     patience rather than reporting a failure;
+//   - network errors and 5xx responses count toward maxRetries and the
+//     circuit breaker, retrying with full-jitter exponential backoff.
 func (c *Client) doRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 
-	var lastErr error
-	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		resp, err := c.httpClient.Do(req.WithContext(ctx))
+	start := time.Now()
+	maxElapsed := c.maxElapsedOrDefault()
+	elapsedExceeded := func() bool {
+		return maxElapsed > 0 && time.Since(start) > maxElapsed
+	}
+
+	// If the request has a body we can't rewind for a second attempt (e.g. a
+	// plain io.Reader already consumed on the first try), a retry would
+	// silently send an empty body, so don't attempt one.
+	canRetryBody := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		if err := c.breaker.Allow(); err != nil {
+			return nil, err
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq := req.Clone(ctx)
+		if attempt > 0 && req.GetBody != nil {
+			body, gerr := req.GetBody()
+			if gerr != nil {
+				return nil, gerr
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
 		if err != nil {
-			lastErr = NewNetworkError(err.Error())
-			// Exponential backoff with retry logging
-			if attempt < c.maxRetries {
-				backoff := time.Duration(1<<attempt) * 100 * time.Millisecond
-				c.logf("Retry attempt %d/%d, waiting %dms", attempt+1, c.maxRetries, backoff.Milliseconds())
-				time.Sleep(backoff)
+			netErr := NewNetworkError(err)
+			c.breaker.RecordFailure()
+			if attempt >= c.maxRetries || elapsedExceeded() || !canRetryBody {
+				return nil, netErr
+			}
+			sleep := fullJitterBackoff(c.backoffBaseOrDefault(), c.backoffCapOrDefault(), attempt)
+			c.notifyRetry(attempt+1, netErr, sleep)
+			if werr := sleepCtx(ctx, sleep); werr != nil {
+				return nil, werr
 			}
 			continue
 		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfterHeader := resp.Header.Get("Retry-After")
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			var payload map[string]any
+			_ = json.Unmarshal(respBody, &payload)
+			rlErr, _ := ErrorFromHTTPStatus(resp, payload, respBody).(*RateLimitError)
+
+			sleep, ok := parseRetryAfterHeader(retryAfterHeader)
+			if !ok {
+				if rlErr.RetryAfter > 0 {
+					sleep = time.Duration(rlErr.RetryAfter) * time.Second
+				} else {
+					sleep = defaultRateLimitRetryWait
+				}
+			}
+			if elapsedExceeded() || !canRetryBody || !c.retryableStatus(http.StatusTooManyRequests) {
+				return nil, rlErr
+			}
+			c.notifyRetry(attempt+1, rlErr, sleep)
+			if werr := sleepCtx(ctx, sleep); werr != nil {
+				return nil, werr
+			}
+			attempt-- // rate-limit waits don't count toward maxRetries
+			continue
+		}
+
+		if c.retryableStatus(resp.StatusCode) {
+			c.breaker.RecordFailure()
+			if attempt < c.maxRetries && !elapsedExceeded() && canRetryBody {
+				retryAfterHeader := resp.Header.Get("Retry-After")
+				resp.Body.Close()
+				sleep, ok := parseRetryAfterHeader(retryAfterHeader)
+				if !ok {
+					sleep = fullJitterBackoff(c.backoffBaseOrDefault(), c.backoffCapOrDefault(), attempt)
+				}
+				c.notifyRetry(attempt+1, ErrorFromHTTPStatus(resp, nil, nil), sleep)
+				if werr := sleepCtx(ctx, sleep); werr != nil {
+					return nil, werr
+				}
+				continue
+			}
+			return resp, nil
+		}
+
+		c.breaker.RecordSuccess()
 		return resp, nil
 	}
-	return nil, lastErr
 }
 
 // extractPath extracts the path from a URL for logging purposes.
@@ -221,6 +342,14 @@ func (c *Client) request(ctx context.Context, method, path string, body io.Reade
 		req.Header.Set("Content-Type", contentType)
 	}
 
+	return c.sendRequest(ctx, req)
+}
+
+// sendRequest runs req through doRequest (retries, rate limiting, circuit
+// breaking) and reads and decodes its response body. It's the tail end
+// shared by request and uploadFile, which build req differently (the
+// latter needs a GetBody that net/http won't infer on its own).
+func (c *Client) sendRequest(ctx context.Context, req *http.Request) ([]byte, error) {
 	start := time.Now()
 	resp, err := c.doRequest(ctx, req)
 	if err != nil {
@@ -229,55 +358,125 @@ func (c *Client) request(ctx context.Context, method, path string, body io.Reade
 	defer resp.Body.Close()
 
 	elapsed := time.Since(start)
-	c.logf("%s %s -> %d (%dms)", method, extractPath(url), resp.StatusCode, elapsed.Milliseconds())
+	c.logf("%s %s -> %d (%dms)", req.Method, extractPath(req.URL.String()), resp.StatusCode, elapsed.Milliseconds())
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, NewNetworkError(err.Error())
+		return nil, NewNetworkError(err)
 	}
 
 	if resp.StatusCode >= 400 {
 		var payload map[string]any
 		_ = json.Unmarshal(respBody, &payload)
-		return nil, ErrorFromHTTPStatus(resp.StatusCode, resp.Status, payload)
+		return nil, ErrorFromHTTPStatus(resp, payload, respBody)
 	}
 
 	return respBody, nil
 }
 
-func (c *Client) uploadFile(ctx context.Context, path string, filename string, content []byte, fields map[string]string) ([]byte, error) {
-	// Log file upload
-	c.logf("Upload: %s (%s)", filename, formatBytes(int64(len(content))))
+// uploadFile streams r (size bytes of filename's content) as a
+// multipart/form-data request to path. The multipart body is written
+// directly into an io.Pipe from a background goroutine rather than
+// buffered up front, so the encoded body never needs to fit in memory
+// twice over. onProgress, if non-nil, is called as bytes are read off r.
+//
+// r must be an io.ReadSeeker (every caller passes either a *bytes.Reader or
+// an *os.File) so req.GetBody can re-seek it to the start and re-encode the
+// multipart body from scratch on a retry; without that, doRequest's
+// canRetryBody check would see a body it can't rewind and give up after a
+// single attempt, since net/http only infers GetBody for a handful of
+// concrete in-memory reader types — never for an io.Pipe.
+func (c *Client) uploadFile(ctx context.Context, path string, filename string, r io.ReadSeeker, size int64, fields map[string]string, onProgress UploadProgress) ([]byte, error) {
+	c.logf("Upload: %s (%s)", filename, formatBytes(size))
+
+	reporter := c.reporterOrNoop()
+	reporter.StartUpload(filename, size)
+
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+
+	getBody := func() (io.ReadCloser, error) {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
 
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
+		pr, pw := io.Pipe()
+		writer := multipart.NewWriter(pw)
+		if err := writer.SetBoundary(boundary); err != nil {
+			return nil, err
+		}
 
-	// Create part with correct Content-Type header
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, filename))
-	h.Set("Content-Type", getMimeType(filename))
+		go func() {
+			h := make(textproto.MIMEHeader)
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, filename))
+			h.Set("Content-Type", getMimeType(filename))
 
-	part, err := writer.CreatePart(h)
+			part, err := writer.CreatePart(h)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			counted := &progressReader{r: r, total: size, onProgress: func(sent, total int64) {
+				if onProgress != nil {
+					onProgress(sent, total)
+				}
+				reporter.UploadProgress(sent, total)
+			}}
+			if _, err := io.Copy(part, counted); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			for key, value := range fields {
+				if err := writer.WriteField(key, value); err != nil {
+					pw.CloseWithError(err)
+					return
+				}
+			}
+
+			pw.CloseWithError(writer.Close())
+		}()
+
+		return pr, nil
+	}
+
+	body, err := getBody()
 	if err != nil {
+		reporter.Finish(err)
 		return nil, err
 	}
 
-	if _, err := part.Write(content); err != nil {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.buildURL(path), body)
+	if err != nil {
+		reporter.Finish(err)
 		return nil, err
 	}
+	req.GetBody = getBody
+	req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
 
-	// Add additional fields
-	for key, value := range fields {
-		if err := writer.WriteField(key, value); err != nil {
-			return nil, err
-		}
-	}
+	respBody, err := c.sendRequest(ctx, req)
+	reporter.Finish(err)
+	return respBody, err
+}
 
-	if err := writer.Close(); err != nil {
-		return nil, err
-	}
+// progressReader wraps r, invoking onProgress with the running total of
+// bytes read out of total as callers consume it.
+type progressReader struct {
+	r          io.Reader
+	total      int64
+	sent       int64
+	onProgress UploadProgress
+}
 
-	return c.request(ctx, http.MethodPost, path, &buf, writer.FormDataContentType())
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.r.Read(buf)
+	if n > 0 {
+		p.sent += int64(n)
+		if p.onProgress != nil {
+			p.onProgress(p.sent, p.total)
+		}
+	}
+	return n, err
 }
 
 // Rename renames a file using AI.
@@ -298,14 +497,56 @@ func (c *Client) Rename(ctx context.Context, file string, opts *RenameOptions) (
 		return nil, err
 	}
 
-	filename := filepath.Base(file)
-	fields := make(map[string]string)
+	return c.renameContent(ctx, filepath.Base(file), content, opts)
+}
 
+// RenameReader renames a file from an io.Reader.
+func (c *Client) RenameReader(ctx context.Context, reader io.Reader, filename string, opts *RenameOptions) (*RenameResult, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.renameContent(ctx, filename, content, opts)
+}
+
+// RenameFile renames a file the same way Rename does, but streams content
+// directly off disk via os.Open instead of reading it into memory first —
+// a 500 MB PDF doesn't require 500 MB of RAM to rename. This means it
+// can't hash the content up front, so unlike Rename it never consults or
+// populates the cache.
+func (c *Client) RenameFile(ctx context.Context, path string, opts *RenameOptions) (*RenameResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string)
 	if opts != nil && opts.Template != "" {
 		fields["template"] = opts.Template
 	}
 
-	respBody, err := c.uploadFile(ctx, "/rename", filename, content, fields)
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
+
+	filename := filepath.Base(path)
+	size := info.Size()
+
+	var respBody []byte
+	if size > c.resumableThresholdOrDefault() {
+		respBody, err = c.uploadChunked(ctx, "/rename", filename, f, size, fields, onProgress)
+	} else {
+		respBody, err = c.uploadFile(ctx, "/rename", filename, f, size, fields, onProgress)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -318,11 +559,18 @@ func (c *Client) Rename(ctx context.Context, file string, opts *RenameOptions) (
 	return &result, nil
 }
 
-// RenameReader renames a file from an io.Reader.
-func (c *Client) RenameReader(ctx context.Context, reader io.Reader, filename string, opts *RenameOptions) (*RenameResult, error) {
-	content, err := io.ReadAll(reader)
-	if err != nil {
-		return nil, err
+// renameContent is the shared implementation behind Rename and RenameReader:
+// it checks the cache, uploads on a miss, and populates the cache with the
+// result.
+func (c *Client) renameContent(ctx context.Context, filename string, content []byte, opts *RenameOptions) (*RenameResult, error) {
+	key := c.renameCacheKey(content, opts)
+	if key != "" {
+		if cached, ok := c.cache.Get(key); ok {
+			var result RenameResult
+			if err := json.Unmarshal(cached, &result); err == nil {
+				return &result, nil
+			}
+		}
 	}
 
 	fields := make(map[string]string)
@@ -330,7 +578,7 @@ func (c *Client) RenameReader(ctx context.Context, reader io.Reader, filename st
 		fields["template"] = opts.Template
 	}
 
-	respBody, err := c.uploadFile(ctx, "/rename", filename, content, fields)
+	respBody, err := c.uploadRenameContent(ctx, filename, content, fields, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -340,12 +588,43 @@ func (c *Client) RenameReader(ctx context.Context, reader io.Reader, filename st
 		return nil, err
 	}
 
+	if key != "" {
+		_ = c.cache.Set(key, respBody, c.cacheTTLOrDefault())
+	}
+
 	return &result, nil
 }
 
+// renameCacheKey returns the cache key for content+opts, or "" if caching is
+// disabled for this call.
+func (c *Client) renameCacheKey(content []byte, opts *RenameOptions) string {
+	if c.cache == nil || (opts != nil && opts.NoCache) {
+		return ""
+	}
+	return cacheKey("rename", content, canonicalRenameOptionsKey(opts))
+}
+
+// uploadRenameContent sends content to /rename, transparently switching to a
+// resumable chunked upload once content exceeds the client's resumable
+// threshold.
+func (c *Client) uploadRenameContent(ctx context.Context, filename string, content []byte, fields map[string]string, opts *RenameOptions) ([]byte, error) {
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
+
+	if int64(len(content)) > c.resumableThresholdOrDefault() {
+		return c.uploadChunked(ctx, "/rename", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+	}
+
+	return c.uploadFile(ctx, "/rename", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+}
+
 // AsyncJob represents an async job that can be polled for completion.
 type AsyncJob struct {
 	client       *Client
+	jobID        string
 	statusURL    string
 	pollInterval time.Duration
 	maxAttempts  int
@@ -366,14 +645,35 @@ func (j *AsyncJob) Status(ctx context.Context) (*JobStatusResponse, error) {
 	return &status, nil
 }
 
+// RegisterWebhook registers a webhook URL for a job that is already in
+// flight, so its completion is delivered asynchronously instead of
+// requiring the caller to keep polling with Wait. It signs deliveries with
+// the client's configured webhook secret (see WithWebhook); call WithWebhook
+// on the client before starting the job if you want a custom secret.
+func (j *AsyncJob) RegisterWebhook(ctx context.Context, url string) error {
+	body, err := json.Marshal(registerWebhookRequest{
+		WebhookURL:    url,
+		WebhookSecret: j.client.webhookSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = j.client.request(ctx, http.MethodPost, j.statusURL+"/webhook", bytes.NewReader(body), "application/json")
+	return err
+}
+
 // ProgressCallback is called with status updates during Wait.
 type ProgressCallback func(*JobStatusResponse)
 
 // Wait waits for the job to complete, polling at regular intervals.
 func (j *AsyncJob) Wait(ctx context.Context, onProgress ProgressCallback) (*PdfSplitResult, error) {
+	reporter := j.client.reporterOrNoop()
+
 	for attempt := 0; attempt < j.maxAttempts; attempt++ {
 		status, err := j.Status(ctx)
 		if err != nil {
+			reporter.Finish(err)
 			return nil, err
 		}
 
@@ -383,23 +683,78 @@ func (j *AsyncJob) Wait(ctx context.Context, onProgress ProgressCallback) (*PdfS
 		if onProgress != nil {
 			onProgress(status)
 		}
+		reporter.JobProgress(status)
 
 		if status.Status == JobStatusCompleted && status.Result != nil {
+			reporter.Finish(nil)
 			return status.Result, nil
 		}
 
 		if status.Status == JobStatusFailed {
-			return nil, NewJobError(status.Error, status.JobID)
+			err := NewJobError(status.Error, status.JobID)
+			reporter.Finish(err)
+			return nil, err
 		}
 
 		select {
 		case <-ctx.Done():
+			reporter.Finish(ctx.Err())
 			return nil, ctx.Err()
 		case <-time.After(j.pollInterval):
 		}
 	}
 
-	return nil, NewJobError("Job polling timeout exceeded", "")
+	err := NewJobError("Job polling timeout exceeded", "")
+	reporter.Finish(err)
+	return nil, err
+}
+
+// WaitWebhook waits for the job to complete by reading statuses from ch
+// instead of polling, returning the same result type as Wait. Use it to
+// bridge an inbound webhook handler to the rest of the SDK: verify
+// deliveries with the renamed/webhook package (or WithWebhook's configured
+// secret) and forward each decoded *JobStatusResponse into ch.
+//
+// Example:
+//
+//	ch := make(chan *renamed.JobStatusResponse, 1)
+//	http.Handle("/webhooks/renamed", webhook.Handler(secret, func(_ context.Context, s *renamed.JobStatusResponse) error {
+//	    ch <- s
+//	    return nil
+//	}))
+//	result, err := job.WaitWebhook(ctx, ch)
+func (j *AsyncJob) WaitWebhook(ctx context.Context, ch <-chan *JobStatusResponse) (*PdfSplitResult, error) {
+	reporter := j.client.reporterOrNoop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			reporter.Finish(ctx.Err())
+			return nil, ctx.Err()
+		case status, ok := <-ch:
+			if !ok {
+				err := NewJobError("webhook channel closed before job completed", j.jobID)
+				reporter.Finish(err)
+				return nil, err
+			}
+			if status.JobID != j.jobID {
+				continue
+			}
+
+			j.client.logf("Job %s: %s (%d%%)", status.JobID, status.Status, status.Progress)
+			reporter.JobProgress(status)
+
+			if status.Status == JobStatusCompleted && status.Result != nil {
+				reporter.Finish(nil)
+				return status.Result, nil
+			}
+			if status.Status == JobStatusFailed {
+				err := NewJobError(status.Error, status.JobID)
+				reporter.Finish(err)
+				return nil, err
+			}
+		}
+	}
 }
 
 // PDFSplit splits a PDF into multiple documents.
@@ -435,8 +790,9 @@ func (c *Client) PDFSplit(ctx context.Context, file string, opts *PdfSplitOption
 			fields["pagesPerSplit"] = fmt.Sprintf("%d", opts.PagesPerSplit)
 		}
 	}
+	c.addWebhookFieldsForSplit(fields, opts)
 
-	respBody, err := c.uploadFile(ctx, "/pdf-split", filename, content, fields)
+	respBody, err := c.uploadPDFSplitContent(ctx, filename, content, fields, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -448,6 +804,7 @@ func (c *Client) PDFSplit(ctx context.Context, file string, opts *PdfSplitOption
 
 	return &AsyncJob{
 		client:       c,
+		jobID:        resp.JobID,
 		statusURL:    resp.StatusURL,
 		pollInterval: defaultPollInterval,
 		maxAttempts:  maxPollAttempts,
@@ -470,8 +827,68 @@ func (c *Client) PDFSplitReader(ctx context.Context, reader io.Reader, filename
 			fields["pagesPerSplit"] = fmt.Sprintf("%d", opts.PagesPerSplit)
 		}
 	}
+	c.addWebhookFieldsForSplit(fields, opts)
+
+	respBody, err := c.uploadPDFSplitContent(ctx, filename, content, fields, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp pdfSplitResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+
+	return &AsyncJob{
+		client:       c,
+		jobID:        resp.JobID,
+		statusURL:    resp.StatusURL,
+		pollInterval: defaultPollInterval,
+		maxAttempts:  maxPollAttempts,
+	}, nil
+}
+
+// PDFSplitFile splits a PDF the same way PDFSplit does, but streams content
+// directly off disk via os.Open instead of reading it into memory first —
+// a 500 MB PDF doesn't require 500 MB of RAM to submit.
+func (c *Client) PDFSplitFile(ctx context.Context, path string, opts *PdfSplitOptions) (*AsyncJob, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string)
+	if opts != nil {
+		if opts.Mode != "" {
+			fields["mode"] = string(opts.Mode)
+		}
+		if opts.PagesPerSplit > 0 {
+			fields["pagesPerSplit"] = fmt.Sprintf("%d", opts.PagesPerSplit)
+		}
+	}
+	c.addWebhookFieldsForSplit(fields, opts)
+
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
 
-	respBody, err := c.uploadFile(ctx, "/pdf-split", filename, content, fields)
+	filename := filepath.Base(path)
+	size := info.Size()
+
+	var respBody []byte
+	if size > c.resumableThresholdOrDefault() {
+		respBody, err = c.uploadChunked(ctx, "/pdf-split", filename, f, size, fields, onProgress)
+	} else {
+		respBody, err = c.uploadFile(ctx, "/pdf-split", filename, f, size, fields, onProgress)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -483,12 +900,53 @@ func (c *Client) PDFSplitReader(ctx context.Context, reader io.Reader, filename
 
 	return &AsyncJob{
 		client:       c,
+		jobID:        resp.JobID,
 		statusURL:    resp.StatusURL,
 		pollInterval: defaultPollInterval,
 		maxAttempts:  maxPollAttempts,
 	}, nil
 }
 
+// addWebhookFields adds the client's configured webhook URL and secret to
+// fields, if WithWebhook was set, so the server can deliver job completion
+// asynchronously instead of requiring the caller to poll.
+func (c *Client) addWebhookFields(fields map[string]string) {
+	if c.webhookURL == "" {
+		return
+	}
+	fields["webhookUrl"] = c.webhookURL
+	fields["webhookSecret"] = c.webhookSecret
+}
+
+// addWebhookFieldsForSplit is like addWebhookFields, but lets opts.WebhookURL
+// and opts.WebhookSecret override the client's configured webhook for this
+// split only.
+func (c *Client) addWebhookFieldsForSplit(fields map[string]string, opts *PdfSplitOptions) {
+	if opts != nil && opts.WebhookURL != "" {
+		fields["webhookUrl"] = opts.WebhookURL
+		fields["webhookSecret"] = opts.WebhookSecret
+		return
+	}
+	c.addWebhookFields(fields)
+}
+
+// uploadPDFSplitContent sends content to /pdf-split, transparently switching
+// to a resumable chunked upload once content exceeds the client's resumable
+// threshold.
+func (c *Client) uploadPDFSplitContent(ctx context.Context, filename string, content []byte, fields map[string]string, opts *PdfSplitOptions) ([]byte, error) {
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
+
+	if int64(len(content)) > c.resumableThresholdOrDefault() {
+		return c.uploadChunked(ctx, "/pdf-split", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+	}
+
+	return c.uploadFile(ctx, "/pdf-split", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+}
+
 // Extract extracts structured data from a document.
 //
 // Example:
@@ -502,9 +960,37 @@ func (c *Client) Extract(ctx context.Context, file string, opts *ExtractOptions)
 		return nil, err
 	}
 
-	filename := filepath.Base(file)
-	fields := make(map[string]string)
+	return c.extractContent(ctx, filepath.Base(file), content, opts)
+}
 
+// ExtractReader extracts data from an io.Reader.
+func (c *Client) ExtractReader(ctx context.Context, reader io.Reader, filename string, opts *ExtractOptions) (*ExtractResult, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.extractContent(ctx, filename, content, opts)
+}
+
+// ExtractFile extracts structured data the same way Extract does, but
+// streams content directly off disk via os.Open instead of reading it into
+// memory first — a 500 MB PDF doesn't require 500 MB of RAM to extract from.
+// This means it can't hash the content up front, so unlike Extract it never
+// consults or populates the cache.
+func (c *Client) ExtractFile(ctx context.Context, path string, opts *ExtractOptions) (*ExtractResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string)
 	if opts != nil {
 		if opts.Prompt != "" {
 			fields["prompt"] = opts.Prompt
@@ -518,7 +1004,21 @@ func (c *Client) Extract(ctx context.Context, file string, opts *ExtractOptions)
 		}
 	}
 
-	respBody, err := c.uploadFile(ctx, "/extract", filename, content, fields)
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
+
+	filename := filepath.Base(path)
+	size := info.Size()
+
+	var respBody []byte
+	if size > c.resumableThresholdOrDefault() {
+		respBody, err = c.uploadChunked(ctx, "/extract", filename, f, size, fields, onProgress)
+	} else {
+		respBody, err = c.uploadFile(ctx, "/extract", filename, f, size, fields, onProgress)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -531,11 +1031,18 @@ func (c *Client) Extract(ctx context.Context, file string, opts *ExtractOptions)
 	return &result, nil
 }
 
-// ExtractReader extracts data from an io.Reader.
-func (c *Client) ExtractReader(ctx context.Context, reader io.Reader, filename string, opts *ExtractOptions) (*ExtractResult, error) {
-	content, err := io.ReadAll(reader)
-	if err != nil {
-		return nil, err
+// extractContent is the shared implementation behind Extract and
+// ExtractReader: it checks the cache, uploads on a miss, and populates the
+// cache with the result.
+func (c *Client) extractContent(ctx context.Context, filename string, content []byte, opts *ExtractOptions) (*ExtractResult, error) {
+	key := c.extractCacheKey(content, opts)
+	if key != "" {
+		if cached, ok := c.cache.Get(key); ok {
+			var result ExtractResult
+			if err := json.Unmarshal(cached, &result); err == nil {
+				return &result, nil
+			}
+		}
 	}
 
 	fields := make(map[string]string)
@@ -552,7 +1059,19 @@ func (c *Client) ExtractReader(ctx context.Context, reader io.Reader, filename s
 		}
 	}
 
-	respBody, err := c.uploadFile(ctx, "/extract", filename, content, fields)
+	var onProgress UploadProgress
+	if opts != nil {
+		onProgress = opts.OnUploadProgress
+	}
+	onProgress = c.effectiveProgress(onProgress)
+
+	var respBody []byte
+	var err error
+	if int64(len(content)) > c.resumableThresholdOrDefault() {
+		respBody, err = c.uploadChunked(ctx, "/extract", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+	} else {
+		respBody, err = c.uploadFile(ctx, "/extract", filename, bytes.NewReader(content), int64(len(content)), fields, onProgress)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -562,9 +1081,22 @@ func (c *Client) ExtractReader(ctx context.Context, reader io.Reader, filename s
 		return nil, err
 	}
 
+	if key != "" {
+		_ = c.cache.Set(key, respBody, c.cacheTTLOrDefault())
+	}
+
 	return &result, nil
 }
 
+// extractCacheKey returns the cache key for content+opts, or "" if caching
+// is disabled for this call.
+func (c *Client) extractCacheKey(content []byte, opts *ExtractOptions) string {
+	if c.cache == nil || (opts != nil && opts.NoCache) {
+		return ""
+	}
+	return cacheKey("extract", content, canonicalExtractOptionsKey(opts))
+}
+
 // GetUser returns the current user profile and credits.
 //
 // Example:
@@ -601,6 +1133,8 @@ func (c *Client) GetUser(ctx context.Context) (*User, error) {
 //	    os.WriteFile(doc.Filename, content, 0644)
 //	}
 func (c *Client) DownloadFile(ctx context.Context, url string) ([]byte, error) {
+	reporter := c.reporterOrNoop()
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
@@ -611,7 +1145,9 @@ func (c *Client) DownloadFile(ctx context.Context, url string) ([]byte, error) {
 	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, NewNetworkError(err.Error())
+		netErr := NewNetworkError(err)
+		reporter.Finish(netErr)
+		return nil, netErr
 	}
 	defer resp.Body.Close()
 
@@ -619,8 +1155,25 @@ func (c *Client) DownloadFile(ctx context.Context, url string) ([]byte, error) {
 	c.logf("GET %s -> %d (%dms)", extractPath(url), resp.StatusCode, elapsed.Milliseconds())
 
 	if resp.StatusCode >= 400 {
-		return nil, ErrorFromHTTPStatus(resp.StatusCode, resp.Status, nil)
+		rawBody, _ := io.ReadAll(resp.Body)
+		err := ErrorFromHTTPStatus(resp, nil, rawBody)
+		reporter.Finish(err)
+		return nil, err
+	}
+
+	total := resp.ContentLength
+	reporter.StartDownload(filepath.Base(extractPath(url)), total)
+
+	var buf bytes.Buffer
+	counting := &countingWriter{w: &buf, onWrite: func(n int64) {
+		reporter.DownloadProgress(int64(buf.Len()), total)
+	}}
+	if _, err := io.Copy(counting, resp.Body); err != nil {
+		netErr := NewNetworkError(err)
+		reporter.Finish(netErr)
+		return nil, netErr
 	}
 
-	return io.ReadAll(resp.Body)
+	reporter.Finish(nil)
+	return buf.Bytes(), nil
 }
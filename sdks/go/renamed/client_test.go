@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -116,7 +119,33 @@ func TestGetUser(t *testing.T) {
 		}
 	})
 
-	t.Run("returns RateLimitError on 429", func(t *testing.T) {
+	t.Run("retries transparently on 429", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				json.NewEncoder(w).Encode(map[string]any{"error": "Rate limit exceeded", "retryAfter": 0.0})
+				return
+			}
+			json.NewEncoder(w).Encode(User{ID: "user123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		user, err := client.GetUser(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.ID != "user123" {
+			t.Errorf("expected retried request to succeed, got %+v", user)
+		}
+		if calls != 2 {
+			t.Errorf("expected exactly 2 calls (1 rate limited + 1 retry), got %d", calls)
+		}
+	})
+
+	t.Run("gives up on 429 once the context is done", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusTooManyRequests)
 			json.NewEncoder(w).Encode(map[string]any{"error": "Rate limit exceeded", "retryAfter": 60.0})
@@ -124,17 +153,12 @@ func TestGetUser(t *testing.T) {
 		defer server.Close()
 
 		client := NewClient("rt_test123", WithBaseURL(server.URL))
-		_, err := client.GetUser(context.Background())
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
 
+		_, err := client.GetUser(ctx)
 		if err == nil {
-			t.Error("expected error")
-		}
-		rateLimitErr, ok := err.(*RateLimitError)
-		if !ok {
-			t.Errorf("expected RateLimitError, got %T", err)
-		}
-		if rateLimitErr.RetryAfter != 60 {
-			t.Errorf("expected RetryAfter 60, got %d", rateLimitErr.RetryAfter)
+			t.Fatal("expected an error once the context deadline passes")
 		}
 	})
 
@@ -236,6 +260,85 @@ func TestPDFSplit(t *testing.T) {
 			t.Error("expected job")
 		}
 	})
+
+	t.Run("forwards the configured webhook to the server", func(t *testing.T) {
+		var gotURL, gotSecret string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseMultipartForm(1 << 20)
+			gotURL = r.FormValue("webhookUrl")
+			gotSecret = r.FormValue("webhookSecret")
+			json.NewEncoder(w).Encode(pdfSplitResponse{StatusURL: "https://api.example.com/status/job123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithWebhook("https://myapp.example.com/hook", "whsec_test"))
+
+		_, err := client.PDFSplitReader(context.Background(), &mockReader{data: []byte("fake pdf content")}, "test.pdf", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotURL != "https://myapp.example.com/hook" {
+			t.Errorf("expected webhookUrl to be forwarded, got %q", gotURL)
+		}
+		if gotSecret != "whsec_test" {
+			t.Errorf("expected webhookSecret to be forwarded, got %q", gotSecret)
+		}
+	})
+
+	t.Run("per-split webhook overrides the client's configured webhook", func(t *testing.T) {
+		var gotURL, gotSecret string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseMultipartForm(1 << 20)
+			gotURL = r.FormValue("webhookUrl")
+			gotSecret = r.FormValue("webhookSecret")
+			json.NewEncoder(w).Encode(pdfSplitResponse{StatusURL: "https://api.example.com/status/job123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithWebhook("https://myapp.example.com/hook", "whsec_test"))
+
+		_, err := client.PDFSplitReader(context.Background(), &mockReader{data: []byte("fake pdf content")}, "test.pdf", &PdfSplitOptions{
+			WebhookURL:    "https://other.example.com/hook",
+			WebhookSecret: "whsec_other",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotURL != "https://other.example.com/hook" {
+			t.Errorf("expected the per-split webhookUrl to be forwarded, got %q", gotURL)
+		}
+		if gotSecret != "whsec_other" {
+			t.Errorf("expected the per-split webhookSecret to be forwarded, got %q", gotSecret)
+		}
+	})
+}
+
+func TestRegisterWebhook(t *testing.T) {
+	t.Run("posts the webhook URL and secret to the job's webhook endpoint", func(t *testing.T) {
+		var gotPath string
+		var gotBody registerWebhookRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			json.NewDecoder(r.Body).Decode(&gotBody)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithWebhook("", "whsec_test"))
+		job := &AsyncJob{client: client, statusURL: server.URL + "/status/job123"}
+
+		if err := job.RegisterWebhook(context.Background(), "https://myapp.example.com/hook"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotPath != "/status/job123/webhook" {
+			t.Errorf("expected path /status/job123/webhook, got %s", gotPath)
+		}
+		if gotBody.WebhookURL != "https://myapp.example.com/hook" {
+			t.Errorf("expected webhook URL to be sent, got %q", gotBody.WebhookURL)
+		}
+		if gotBody.WebhookSecret != "whsec_test" {
+			t.Errorf("expected webhook secret to be sent, got %q", gotBody.WebhookSecret)
+		}
+	})
 }
 
 func TestAsyncJob(t *testing.T) {
@@ -315,6 +418,50 @@ func TestAsyncJob(t *testing.T) {
 			t.Errorf("expected progress updates 33 and 66, got %v", progressUpdates)
 		}
 	})
+
+	t.Run("WaitWebhook returns once a matching completed status arrives on the channel", func(t *testing.T) {
+		client := NewClient("rt_test123")
+		job := &AsyncJob{client: client, jobID: "job123"}
+
+		ch := make(chan *JobStatusResponse, 2)
+		ch <- &JobStatusResponse{JobID: "job999", Status: JobStatusCompleted, Result: &PdfSplitResult{OriginalFilename: "other.pdf"}}
+		ch <- &JobStatusResponse{JobID: "job123", Status: JobStatusCompleted, Result: &PdfSplitResult{OriginalFilename: "multi.pdf"}}
+
+		result, err := job.WaitWebhook(context.Background(), ch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.OriginalFilename != "multi.pdf" {
+			t.Errorf("expected the matching job's result, got %+v", result)
+		}
+	})
+
+	t.Run("WaitWebhook surfaces a failed job", func(t *testing.T) {
+		client := NewClient("rt_test123")
+		job := &AsyncJob{client: client, jobID: "job123"}
+
+		ch := make(chan *JobStatusResponse, 1)
+		ch <- &JobStatusResponse{JobID: "job123", Status: JobStatusFailed, Error: "split failed"}
+
+		_, err := job.WaitWebhook(context.Background(), ch)
+		var jobErr *JobError
+		if !errors.As(err, &jobErr) {
+			t.Fatalf("expected a *JobError, got %T: %v", err, err)
+		}
+	})
+
+	t.Run("WaitWebhook returns ctx.Err when ctx is canceled before a status arrives", func(t *testing.T) {
+		client := NewClient("rt_test123")
+		job := &AsyncJob{client: client, jobID: "job123"}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := job.WaitWebhook(ctx, make(chan *JobStatusResponse))
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
 }
 
 // mockReader is a simple io.Reader for testing
@@ -0,0 +1,225 @@
+package renamed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const defaultDownloadConcurrency = 4
+
+// DownloadSplitDocument downloads a single split document's full content to
+// w, authenticating with the client's bearer token and honoring the
+// client's retry policy. It returns the number of bytes written.
+//
+// Example:
+//
+//	f, _ := os.Create(doc.Filename)
+//	defer f.Close()
+//	n, err := client.DownloadSplitDocument(ctx, doc, f)
+func (c *Client) DownloadSplitDocument(ctx context.Context, doc SplitDocument, w io.Writer) (int64, error) {
+	return c.downloadRange(ctx, doc.DownloadURL, w, -1, -1)
+}
+
+// DownloadSplitDocumentRange downloads the byte range [start, end]
+// (inclusive) of a split document to w, relying on server support for HTTP
+// Range requests and 206 Partial Content responses.
+func (c *Client) DownloadSplitDocumentRange(ctx context.Context, doc SplitDocument, w io.Writer, start, end int64) (int64, error) {
+	return c.downloadRange(ctx, doc.DownloadURL, w, start, end)
+}
+
+// downloadRange issues a GET against url, optionally with a Range header,
+// and copies the response body to w.
+func (c *Client) downloadRange(ctx context.Context, url string, w io.Writer, start, end int64) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if start >= 0 {
+		if end >= 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+		} else {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", start))
+		}
+	}
+
+	resp, err := c.doRequest(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		rawBody, _ := io.ReadAll(resp.Body)
+		return 0, ErrorFromHTTPStatus(resp, nil, rawBody)
+	}
+	if start >= 0 && resp.StatusCode != http.StatusPartialContent {
+		return 0, fmt.Errorf("renamed: server does not support range requests (got status %d)", resp.StatusCode)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, NewNetworkError(err)
+	}
+	return n, nil
+}
+
+// countingWriter wraps an io.Writer and invokes onWrite after every
+// successful write, used to drive download progress callbacks.
+type countingWriter struct {
+	w       io.Writer
+	onWrite func(n int64)
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	if n > 0 && cw.onWrite != nil {
+		cw.onWrite(int64(n))
+	}
+	return n, err
+}
+
+// downloadToFile downloads doc to destPath, resuming a partial file if one
+// exists and the server's ETag still matches (via If-Range), and restarting
+// from scratch if the server-side blob has since changed.
+func (c *Client) downloadToFile(ctx context.Context, doc SplitDocument, destPath string, onProgress func(done, total int64)) error {
+	etagPath := destPath + ".etag"
+
+	var startOffset int64
+	var ifRangeETag string
+
+	if info, err := os.Stat(destPath); err == nil {
+		if etagBytes, err := os.ReadFile(etagPath); err == nil {
+			startOffset = info.Size()
+			ifRangeETag = strings.TrimSpace(string(etagBytes))
+		}
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY
+	if startOffset > 0 {
+		flags |= os.O_APPEND
+	} else {
+		flags |= os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(destPath, flags, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, doc.DownloadURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if startOffset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", startOffset))
+		if ifRangeETag != "" {
+			req.Header.Set("If-Range", ifRangeETag)
+		}
+	}
+
+	resp, err := c.doRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		rawBody, _ := io.ReadAll(resp.Body)
+		return ErrorFromHTTPStatus(resp, nil, rawBody)
+	}
+
+	// The server ignored the range, or the blob changed underneath us:
+	// restart the file from scratch rather than risk silent corruption.
+	if startOffset > 0 && resp.StatusCode != http.StatusPartialContent {
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		startOffset = 0
+	}
+
+	done := startOffset
+	counting := &countingWriter{w: f, onWrite: func(n int64) {
+		done += n
+		if onProgress != nil {
+			onProgress(done, doc.Size)
+		}
+	}}
+
+	if _, err := io.Copy(counting, resp.Body); err != nil {
+		return NewNetworkError(err)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		_ = os.WriteFile(etagPath, []byte(etag), 0644)
+	} else {
+		_ = os.Remove(etagPath)
+	}
+
+	return nil
+}
+
+// DownloadAll downloads every document in result into destDir concurrently,
+// resuming partially-downloaded files via ETag/If-Range when possible.
+//
+// onProgress may be called concurrently from multiple goroutines, one per
+// in-flight document; callers must synchronize any state it touches (e.g.
+// with a mutex or atomic operations).
+//
+// Example:
+//
+//	var mu sync.Mutex
+//	err := client.DownloadAll(ctx, result, "./output", 4, func(doc renamed.SplitDocument, done, total int64) {
+//	    mu.Lock()
+//	    defer mu.Unlock()
+//	    fmt.Printf("\r%s: %d/%d", doc.Filename, done, total)
+//	})
+func (c *Client) DownloadAll(ctx context.Context, result *PdfSplitResult, destDir string, concurrency int, onProgress func(doc SplitDocument, done, total int64)) error {
+	if concurrency <= 0 {
+		concurrency = defaultDownloadConcurrency
+	}
+
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return err
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	errs := make([]error, len(result.Documents))
+
+	for i, doc := range result.Documents {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, doc SplitDocument) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			destPath := filepath.Join(destDir, doc.Filename)
+			errs[i] = c.downloadToFile(ctx, doc, destPath, func(done, total int64) {
+				if onProgress != nil {
+					onProgress(doc, done, total)
+				}
+			})
+		}(i, doc)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package renamed
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDownloadSplitDocument(t *testing.T) {
+	t.Run("downloads the full document", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("document contents"))
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		doc := SplitDocument{Filename: "doc1.pdf", DownloadURL: server.URL + "/doc1.pdf"}
+
+		var buf bytes.Buffer
+		n, err := client.DownloadSplitDocument(context.Background(), doc, &buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != int64(buf.Len()) {
+			t.Errorf("expected n to match bytes written, got n=%d len=%d", n, buf.Len())
+		}
+		if buf.String() != "document contents" {
+			t.Errorf("unexpected content: %s", buf.String())
+		}
+	})
+}
+
+func TestDownloadSplitDocumentRange(t *testing.T) {
+	t.Run("errors when the server ignores the range", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("full content"))
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		doc := SplitDocument{Filename: "doc1.pdf", DownloadURL: server.URL + "/doc1.pdf"}
+
+		var buf bytes.Buffer
+		_, err := client.DownloadSplitDocumentRange(context.Background(), doc, &buf, 0, 3)
+		if err == nil {
+			t.Error("expected error when server does not honor range request")
+		}
+	})
+
+	t.Run("succeeds on 206 Partial Content", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Range", "bytes 0-3/12")
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte("full"))
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+		doc := SplitDocument{Filename: "doc1.pdf", DownloadURL: server.URL + "/doc1.pdf"}
+
+		var buf bytes.Buffer
+		n, err := client.DownloadSplitDocumentRange(context.Background(), doc, &buf, 0, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 4 {
+			t.Errorf("expected 4 bytes, got %d", n)
+		}
+	})
+}
+
+func TestDownloadAll(t *testing.T) {
+	t.Run("downloads every document concurrently", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("contents of " + r.URL.Path))
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		result := &PdfSplitResult{
+			Documents: []SplitDocument{
+				{Filename: "a.pdf", DownloadURL: server.URL + "/a.pdf", Size: 11},
+				{Filename: "b.pdf", DownloadURL: server.URL + "/b.pdf", Size: 11},
+			},
+		}
+
+		destDir := t.TempDir()
+
+		var progressCount int32
+		err := client.DownloadAll(context.Background(), result, destDir, 2, func(doc SplitDocument, done, total int64) {
+			atomic.AddInt32(&progressCount, 1)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if progressCount == 0 {
+			t.Error("expected progress callback to fire")
+		}
+
+		for _, name := range []string{"a.pdf", "b.pdf"} {
+			content, err := os.ReadFile(filepath.Join(destDir, name))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", name, err)
+			}
+			if len(content) == 0 {
+				t.Errorf("expected non-empty content for %s", name)
+			}
+		}
+	})
+}
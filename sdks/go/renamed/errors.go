@@ -2,7 +2,23 @@
 package renamed
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors for use with errors.Is. Each concrete error type below
+// matches the sentinel for its category, so callers can write
+// errors.Is(err, renamed.ErrRateLimit) without a type assertion.
+var (
+	ErrAuthentication      = errors.New("renamed: authentication error")
+	ErrRateLimit           = errors.New("renamed: rate limit error")
+	ErrInsufficientCredits = errors.New("renamed: insufficient credits error")
+	ErrValidation          = errors.New("renamed: validation error")
+	ErrNetwork             = errors.New("renamed: network error")
+	ErrTimeout             = errors.New("renamed: timeout error")
+	ErrJob                 = errors.New("renamed: job error")
 )
 
 // RenamedError is the base error type for all SDK errors.
@@ -11,6 +27,18 @@ type RenamedError struct {
 	Code       string
 	StatusCode int
 	Details    any
+
+	// RawBody is the raw HTTP response body, if this error was constructed
+	// from one (see ErrorFromHTTPStatus).
+	RawBody []byte
+
+	// RequestID is the server-assigned X-Request-ID for the request that
+	// produced this error, if the server sent one.
+	RequestID string
+
+	// Err is the underlying error this one wraps, if any (e.g. the
+	// transport error behind a NetworkError). May be nil.
+	Err error
 }
 
 func (e *RenamedError) Error() string {
@@ -20,6 +48,26 @@ func (e *RenamedError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Code, e.Message)
 }
 
+// Unwrap returns the underlying error, if any, so errors.Is and errors.As
+// can see through it (e.g. to a context.Canceled or *url.Error).
+func (e *RenamedError) Unwrap() error {
+	return e.Err
+}
+
+// base returns e itself, letting callers that only hold a concrete
+// subtype (e.g. *AuthenticationError) reach the embedded RenamedError
+// through the renamedErrorBase interface.
+func (e *RenamedError) base() *RenamedError {
+	return e
+}
+
+// renamedErrorBase is implemented by every concrete error type below via
+// its embedded RenamedError, letting ErrorFromHTTPStatus attach RawBody and
+// RequestID without each constructor needing to accept them.
+type renamedErrorBase interface {
+	base() *RenamedError
+}
+
 // AuthenticationError indicates invalid or missing API key.
 type AuthenticationError struct {
 	RenamedError
@@ -39,6 +87,11 @@ func NewAuthenticationError(message string) *AuthenticationError {
 	}
 }
 
+// Is reports whether target is ErrAuthentication, for errors.Is.
+func (e *AuthenticationError) Is(target error) bool {
+	return target == ErrAuthentication
+}
+
 // RateLimitError indicates rate limit exceeded.
 type RateLimitError struct {
 	RenamedError
@@ -60,6 +113,11 @@ func NewRateLimitError(message string, retryAfter int) *RateLimitError {
 	}
 }
 
+// Is reports whether target is ErrRateLimit, for errors.Is.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimit
+}
+
 // ValidationError indicates invalid request parameters.
 type ValidationError struct {
 	RenamedError
@@ -77,24 +135,38 @@ func NewValidationError(message string, details any) *ValidationError {
 	}
 }
 
+// Is reports whether target is ErrValidation, for errors.Is.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
 // NetworkError indicates a network connection failure.
 type NetworkError struct {
 	RenamedError
 }
 
-// NewNetworkError creates a new network error.
-func NewNetworkError(message string) *NetworkError {
-	if message == "" {
-		message = "Network request failed"
+// NewNetworkError creates a new network error wrapping the underlying
+// transport error err, so errors.As(err, &netErr) followed by
+// errors.As(netErr.Unwrap(), &urlErr) both work.
+func NewNetworkError(err error) *NetworkError {
+	message := "Network request failed"
+	if err != nil {
+		message = err.Error()
 	}
 	return &NetworkError{
 		RenamedError: RenamedError{
 			Message: message,
 			Code:    "NETWORK_ERROR",
+			Err:     err,
 		},
 	}
 }
 
+// Is reports whether target is ErrNetwork, for errors.Is.
+func (e *NetworkError) Is(target error) bool {
+	return target == ErrNetwork
+}
+
 // TimeoutError indicates a request timeout.
 type TimeoutError struct {
 	RenamedError
@@ -113,6 +185,11 @@ func NewTimeoutError(message string) *TimeoutError {
 	}
 }
 
+// Is reports whether target is ErrTimeout, for errors.Is.
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
 // InsufficientCreditsError indicates not enough credits.
 type InsufficientCreditsError struct {
 	RenamedError
@@ -132,6 +209,11 @@ func NewInsufficientCreditsError(message string) *InsufficientCreditsError {
 	}
 }
 
+// Is reports whether target is ErrInsufficientCredits, for errors.Is.
+func (e *InsufficientCreditsError) Is(target error) bool {
+	return target == ErrInsufficientCredits
+}
+
 // JobError indicates an async job failure.
 type JobError struct {
 	RenamedError
@@ -149,22 +231,50 @@ func NewJobError(message string, jobID string) *JobError {
 	}
 }
 
-// ErrorFromHTTPStatus creates an appropriate error from an HTTP status code.
-func ErrorFromHTTPStatus(status int, statusText string, payload map[string]any) error {
-	message := statusText
+// Is reports whether target is ErrJob, for errors.Is.
+func (e *JobError) Is(target error) bool {
+	return target == ErrJob
+}
+
+// CircuitOpenError indicates the circuit breaker is open after repeated
+// upstream failures and the request was fast-failed without being sent.
+type CircuitOpenError struct {
+	RenamedError
+	RetryAfter time.Duration
+}
+
+// NewCircuitOpenError creates a new circuit open error. retryAfter is how
+// long remains until the breaker allows a half-open probe.
+func NewCircuitOpenError(retryAfter time.Duration) *CircuitOpenError {
+	return &CircuitOpenError{
+		RenamedError: RenamedError{
+			Message: fmt.Sprintf("circuit breaker open, retry after %s", retryAfter),
+			Code:    "CIRCUIT_OPEN",
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// ErrorFromHTTPStatus creates an appropriate error from resp's status code,
+// attaching rawBody and resp's X-Request-ID header (if present) for
+// debugging. payload is the response body already decoded as JSON, or nil
+// if it wasn't JSON or hadn't been read.
+func ErrorFromHTTPStatus(resp *http.Response, payload map[string]any, rawBody []byte) error {
+	message := resp.Status
 	if payload != nil {
 		if errMsg, ok := payload["error"].(string); ok {
 			message = errMsg
 		}
 	}
 
-	switch status {
+	var err error
+	switch resp.StatusCode {
 	case 401:
-		return NewAuthenticationError(message)
+		err = NewAuthenticationError(message)
 	case 402:
-		return NewInsufficientCreditsError(message)
+		err = NewInsufficientCreditsError(message)
 	case 400, 422:
-		return NewValidationError(message, payload)
+		err = NewValidationError(message, payload)
 	case 429:
 		retryAfter := 0
 		if payload != nil {
@@ -172,13 +282,21 @@ func ErrorFromHTTPStatus(status int, statusText string, payload map[string]any)
 				retryAfter = int(ra)
 			}
 		}
-		return NewRateLimitError(message, retryAfter)
+		err = NewRateLimitError(message, retryAfter)
 	default:
-		return &RenamedError{
+		err = &RenamedError{
 			Message:    message,
 			Code:       "API_ERROR",
-			StatusCode: status,
+			StatusCode: resp.StatusCode,
 			Details:    payload,
 		}
 	}
+
+	if be, ok := err.(renamedErrorBase); ok {
+		b := be.base()
+		b.RawBody = rawBody
+		b.RequestID = resp.Header.Get("X-Request-ID")
+	}
+
+	return err
 }
@@ -0,0 +1,112 @@
+package renamed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestErrorSentinels(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{"authentication", NewAuthenticationError(""), ErrAuthentication},
+		{"rate limit", NewRateLimitError("", 0), ErrRateLimit},
+		{"insufficient credits", NewInsufficientCreditsError(""), ErrInsufficientCredits},
+		{"validation", NewValidationError("bad field", nil), ErrValidation},
+		{"network", NewNetworkError(nil), ErrNetwork},
+		{"timeout", NewTimeoutError(""), ErrTimeout},
+		{"job", NewJobError("failed", "job1"), ErrJob},
+	}
+
+	allSentinels := []error{
+		ErrAuthentication, ErrRateLimit, ErrInsufficientCredits,
+		ErrValidation, ErrNetwork, ErrTimeout, ErrJob,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.target) {
+				t.Errorf("expected errors.Is(%T, %v) to be true", tt.err, tt.target)
+			}
+			for _, sentinel := range allSentinels {
+				if sentinel == tt.target {
+					continue
+				}
+				if errors.Is(tt.err, sentinel) {
+					t.Errorf("expected %T not to match unrelated sentinel %v", tt.err, sentinel)
+				}
+			}
+		})
+	}
+}
+
+func TestNetworkErrorUnwrap(t *testing.T) {
+	t.Run("wraps the underlying transport error", func(t *testing.T) {
+		urlErr := &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}
+		netErr := NewNetworkError(urlErr)
+
+		if !errors.Is(netErr, context.Canceled) {
+			t.Error("expected errors.Is to see through NetworkError to context.Canceled")
+		}
+
+		var gotURLErr *url.Error
+		if !errors.As(netErr.Unwrap(), &gotURLErr) {
+			t.Error("expected errors.As(netErr.Unwrap(), &urlErr) to succeed")
+		}
+
+		var gotNetErr *NetworkError
+		if !errors.As(error(netErr), &gotNetErr) {
+			t.Error("expected errors.As(err, &netErr) to succeed")
+		}
+	})
+
+	t.Run("falls back to a default message when err is nil", func(t *testing.T) {
+		netErr := NewNetworkError(nil)
+		if netErr.Message != "Network request failed" {
+			t.Errorf("unexpected message: %q", netErr.Message)
+		}
+	})
+}
+
+func TestErrorFromHTTPStatus(t *testing.T) {
+	t.Run("attaches the raw body and X-Request-ID header", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: 402,
+			Status:     "402 Payment Required",
+			Header:     http.Header{"X-Request-Id": []string{"req_abc123"}},
+		}
+		rawBody := []byte(`{"error":"insufficient credits"}`)
+
+		err := ErrorFromHTTPStatus(resp, map[string]any{"error": "insufficient credits"}, rawBody)
+
+		var creditsErr *InsufficientCreditsError
+		if !errors.As(err, &creditsErr) {
+			t.Fatalf("expected *InsufficientCreditsError, got %T", err)
+		}
+		if creditsErr.RequestID != "req_abc123" {
+			t.Errorf("expected RequestID to be attached, got %q", creditsErr.RequestID)
+		}
+		if string(creditsErr.RawBody) != string(rawBody) {
+			t.Errorf("expected RawBody to be attached, got %q", creditsErr.RawBody)
+		}
+	})
+
+	t.Run("falls back to the generic API_ERROR for unmapped status codes", func(t *testing.T) {
+		resp := &http.Response{StatusCode: 503, Status: "503 Service Unavailable", Header: http.Header{}}
+
+		err := ErrorFromHTTPStatus(resp, nil, nil)
+
+		var renamedErr *RenamedError
+		if !errors.As(err, &renamedErr) {
+			t.Fatalf("expected *RenamedError, got %T", err)
+		}
+		if renamedErr.Code != "API_ERROR" || renamedErr.StatusCode != 503 {
+			t.Errorf("unexpected error: %+v", renamedErr)
+		}
+	})
+}
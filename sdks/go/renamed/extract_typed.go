@@ -0,0 +1,158 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// ExtractInto extracts structured data from filePath into a new value of
+// type T. The JSON schema sent to the extract endpoint is built from T's
+// exported fields, honoring `json` tags for field names and
+// `extract:"description=...,required"` tags for field documentation and
+// required-ness. Alongside the overall confidence, it returns the server's
+// per-field confidence scores, keyed by the same `json` field names used to
+// build the schema.
+//
+// Example:
+//
+//	type Invoice struct {
+//	    Number string  `json:"number" extract:"description=Invoice number,required"`
+//	    Total  float64 `json:"total" extract:"description=Total amount due"`
+//	}
+//
+//	invoice, confidence, fieldConfidence, err := renamed.ExtractInto[Invoice](ctx, client, "invoice.pdf", "Extract the invoice details")
+func ExtractInto[T any](ctx context.Context, c *Client, filePath string, prompt string) (*T, float64, map[string]float64, error) {
+	var zero T
+	schema := SchemaFromStruct(zero)
+
+	result, err := c.Extract(ctx, filePath, &ExtractOptions{
+		Prompt: prompt,
+		Schema: schema,
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	dataJSON, err := json.Marshal(result.Data)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	var value T
+	if err := json.Unmarshal(dataJSON, &value); err != nil {
+		return nil, 0, nil, err
+	}
+
+	return &value, result.Confidence, result.FieldConfidence, nil
+}
+
+// SchemaFromStruct builds a JSON-schema object describing v's exported
+// struct fields, so callers can inspect or tweak it before passing it as
+// ExtractOptions.Schema. Field names follow the `json` tag; descriptions and
+// required-ness come from an `extract:"description=...,required"` tag.
+func SchemaFromStruct(v any) map[string]any {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return map[string]any{"type": "object"}
+	}
+
+	properties := map[string]any{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported field
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue // `json:"-"`
+		}
+
+		description, isRequired := parseExtractTag(field.Tag.Get("extract"))
+
+		prop := map[string]any{"type": jsonSchemaType(field.Type)}
+		if description != "" {
+			prop["description"] = description
+		}
+		properties[name] = prop
+
+		if isRequired {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// jsonFieldName returns field's JSON name per its `json` tag, falling back
+// to the Go field name. ok is false if the field is excluded via `json:"-"`.
+func jsonFieldName(field reflect.StructField) (name string, ok bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name, true
+	}
+
+	parts := strings.Split(tag, ",")
+	switch parts[0] {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return parts[0], true
+	}
+}
+
+// parseExtractTag parses an `extract:"description=...,required"` tag.
+func parseExtractTag(tag string) (description string, required bool) {
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "required":
+			required = true
+		case strings.HasPrefix(part, "description="):
+			description = strings.TrimPrefix(part, "description=")
+		}
+	}
+	return description, required
+}
+
+// jsonSchemaType maps a Go type to its closest JSON schema primitive type.
+func jsonSchemaType(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,98 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testInvoice struct {
+	Number   string  `json:"number" extract:"description=Invoice number,required"`
+	Total    float64 `json:"total" extract:"description=Total amount due"`
+	internal string
+}
+
+func TestSchemaFromStruct(t *testing.T) {
+	schema := SchemaFromStruct(testInvoice{})
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected properties map, got %T", schema["properties"])
+	}
+
+	number, ok := properties["number"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected number property, got %v", properties["number"])
+	}
+	if number["type"] != "string" {
+		t.Errorf("expected number type string, got %v", number["type"])
+	}
+	if number["description"] != "Invoice number" {
+		t.Errorf("expected description, got %v", number["description"])
+	}
+
+	total, ok := properties["total"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected total property, got %v", properties["total"])
+	}
+	if total["type"] != "number" {
+		t.Errorf("expected total type number, got %v", total["type"])
+	}
+
+	if _, ok := properties["internal"]; ok {
+		t.Error("expected unexported field to be skipped")
+	}
+
+	required, ok := schema["required"].([]string)
+	if !ok || len(required) != 1 || required[0] != "number" {
+		t.Errorf("expected required=[number], got %v", schema["required"])
+	}
+}
+
+func TestExtractInto(t *testing.T) {
+	t.Run("unmarshals extracted data into T", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(ExtractResult{
+				Data: map[string]any{
+					"number": "INV-001",
+					"total":  42.5,
+				},
+				Confidence: 0.87,
+				FieldConfidence: map[string]float64{
+					"number": 0.99,
+					"total":  0.75,
+				},
+			})
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		file := filepath.Join(dir, "invoice.pdf")
+		if err := os.WriteFile(file, []byte("fake"), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		invoice, confidence, fieldConfidence, err := ExtractInto[testInvoice](context.Background(), client, file, "Extract the invoice details")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if invoice.Number != "INV-001" {
+			t.Errorf("expected number INV-001, got %s", invoice.Number)
+		}
+		if invoice.Total != 42.5 {
+			t.Errorf("expected total 42.5, got %f", invoice.Total)
+		}
+		if confidence != 0.87 {
+			t.Errorf("expected confidence 0.87, got %f", confidence)
+		}
+		if fieldConfidence["number"] != 0.99 || fieldConfidence["total"] != 0.75 {
+			t.Errorf("expected per-field confidence to pass through, got %v", fieldConfidence)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+// Package progress provides ready-made renamed.Reporter implementations for
+// CLIs and other user-facing callers of the renamed.to SDK.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/renamed-to/renamed-sdk/sdks/go/renamed"
+)
+
+// NoopReporter discards all progress events. It's useful as an explicit "no
+// progress output" choice, distinct from simply not calling WithReporter.
+type NoopReporter struct{}
+
+func (NoopReporter) StartUpload(filename string, size int64)       {}
+func (NoopReporter) UploadProgress(sent, total int64)              {}
+func (NoopReporter) JobProgress(status *renamed.JobStatusResponse) {}
+func (NoopReporter) StartDownload(filename string, size int64)     {}
+func (NoopReporter) DownloadProgress(received, total int64)        {}
+func (NoopReporter) Finish(err error)                              {}
+
+var _ renamed.Reporter = NoopReporter{}
+
+// TerminalReporter renders upload, download, and job progress as an inline
+// progress bar, redrawn in place with carriage returns, in the style of
+// cheggaaa/pb. Its fields are guarded by an internal mutex, but it still
+// assumes only one operation's Start*/Progress/Finish sequence is in flight
+// at a time: driving two operations through the same TerminalReporter
+// concurrently will interleave their labels and progress into garbled
+// output. renamed.Client's concurrent batch APIs (RenameBatch, ExtractBatch,
+// PDFSplitBatch) account for this themselves by serializing worker access
+// to a shared Reporter, so a TerminalReporter passed via WithReporter is
+// safe to use with them.
+type TerminalReporter struct {
+	// Out is where the progress bar is written. Defaults to os.Stderr.
+	Out io.Writer
+
+	// Width is the bar's width in characters. Defaults to 30.
+	Width int
+
+	mu      sync.Mutex
+	label   string
+	total   int64
+	started time.Time
+	drawn   bool
+}
+
+var _ renamed.Reporter = (*TerminalReporter)(nil)
+
+func (t *TerminalReporter) StartUpload(filename string, size int64) {
+	t.start(filename, size)
+}
+
+func (t *TerminalReporter) UploadProgress(sent, total int64) {
+	t.draw(sent, total, true)
+}
+
+func (t *TerminalReporter) StartDownload(filename string, size int64) {
+	t.start(filename, size)
+}
+
+func (t *TerminalReporter) DownloadProgress(received, total int64) {
+	t.draw(received, total, true)
+}
+
+func (t *TerminalReporter) JobProgress(status *renamed.JobStatusResponse) {
+	t.mu.Lock()
+	if t.label == "" {
+		t.label = status.JobID
+		t.started = time.Now()
+	}
+	t.mu.Unlock()
+	t.draw(int64(status.Progress), 100, false)
+}
+
+// Finish prints a trailing newline (or the error) once an operation that
+// produced at least one progress update completes.
+func (t *TerminalReporter) Finish(err error) {
+	t.mu.Lock()
+	drawn := t.drawn
+	t.drawn = false
+	t.label = ""
+	t.mu.Unlock()
+
+	if !drawn {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(t.out(), " failed: %v\n", err)
+		return
+	}
+	fmt.Fprintln(t.out())
+}
+
+func (t *TerminalReporter) start(label string, total int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.label = label
+	t.total = total
+	t.started = time.Now()
+}
+
+// draw redraws the bar in place. showRate controls whether a transfer
+// speed/ETA is appended, which isn't meaningful for job-status percentages.
+func (t *TerminalReporter) draw(done, total int64, showRate bool) {
+	t.mu.Lock()
+	if total <= 0 {
+		total = t.total
+	}
+	label := t.label
+	started := t.started
+	t.drawn = true
+	t.mu.Unlock()
+
+	var pct float64
+	if total > 0 {
+		pct = float64(done) / float64(total)
+		if pct > 1 {
+			pct = 1
+		}
+	}
+
+	width := t.Width
+	if width <= 0 {
+		width = 30
+	}
+	filled := int(pct * float64(width))
+	bar := "[" + strings.Repeat("=", filled) + strings.Repeat(" ", width-filled) + "]"
+
+	stats := ""
+	if showRate {
+		elapsed := time.Since(started).Seconds()
+		var speed float64
+		if elapsed > 0 {
+			speed = float64(done) / elapsed
+		}
+		eta := "?"
+		if speed > 0 && total > done {
+			eta = time.Duration(float64(total-done) / speed * float64(time.Second)).Round(time.Second).String()
+		}
+		stats = fmt.Sprintf(" %s/s ETA %s", formatBytes(int64(speed)), eta)
+	}
+
+	fmt.Fprintf(t.out(), "\r%s %s %3.0f%%%s", label, bar, pct*100, stats)
+}
+
+func (t *TerminalReporter) out() io.Writer {
+	if t.Out != nil {
+		return t.Out
+	}
+	return os.Stderr
+}
+
+// formatBytes renders n bytes using the same KB/MB/GB thresholds as the
+// main SDK's logging output.
+func formatBytes(n int64) string {
+	const (
+		KB = 1024
+		MB = KB * 1024
+		GB = MB * 1024
+	)
+	switch {
+	case n >= GB:
+		return fmt.Sprintf("%.1f GB", float64(n)/float64(GB))
+	case n >= MB:
+		return fmt.Sprintf("%.1f MB", float64(n)/float64(MB))
+	case n >= KB:
+		return fmt.Sprintf("%.1f KB", float64(n)/float64(KB))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
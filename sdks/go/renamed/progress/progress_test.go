@@ -0,0 +1,54 @@
+package progress
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/renamed-to/renamed-sdk/sdks/go/renamed"
+)
+
+func TestNoopReporter(t *testing.T) {
+	t.Run("discards every event without panicking", func(t *testing.T) {
+		var r renamed.Reporter = NoopReporter{}
+		r.StartUpload("a.pdf", 100)
+		r.UploadProgress(50, 100)
+		r.JobProgress(&renamed.JobStatusResponse{JobID: "job1", Progress: 50})
+		r.StartDownload("a.pdf", 100)
+		r.DownloadProgress(50, 100)
+		r.Finish(nil)
+	})
+}
+
+func TestTerminalReporter(t *testing.T) {
+	t.Run("redraws the bar inline and prints a trailing newline on Finish", func(t *testing.T) {
+		var buf bytes.Buffer
+		r := &TerminalReporter{Out: &buf, Width: 10}
+
+		r.StartUpload("a.pdf", 100)
+		r.UploadProgress(50, 100)
+		r.UploadProgress(100, 100)
+		r.Finish(nil)
+
+		out := buf.String()
+		if !strings.Contains(out, "a.pdf") {
+			t.Errorf("expected the filename in the output, got %q", out)
+		}
+		if !strings.Contains(out, "\r") {
+			t.Errorf("expected carriage-return redraws, got %q", out)
+		}
+		if !strings.HasSuffix(out, "\n") {
+			t.Errorf("expected Finish to print a trailing newline, got %q", out)
+		}
+	})
+
+	t.Run("does nothing on Finish if nothing was ever drawn", func(t *testing.T) {
+		var buf bytes.Buffer
+		r := &TerminalReporter{Out: &buf}
+		r.Finish(nil)
+
+		if buf.Len() != 0 {
+			t.Errorf("expected no output, got %q", buf.String())
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package renamed
+
+import "sync"
+
+// Reporter receives progress events for uploads, job polling, and downloads,
+// so CLIs and other user-facing callers can render a consistent UI without
+// threading ad-hoc callbacks through every call site. See the renamed/progress
+// subpackage for ready-made implementations.
+type Reporter interface {
+	// StartUpload is called once before a file upload begins. size is -1 if
+	// unknown.
+	StartUpload(filename string, size int64)
+
+	// UploadProgress is called as an upload's bytes are sent.
+	UploadProgress(sent, total int64)
+
+	// JobProgress is called with each status update while polling an
+	// AsyncJob via Wait.
+	JobProgress(status *JobStatusResponse)
+
+	// StartDownload is called once before a file download begins. size is
+	// -1 if unknown (e.g. the server didn't send a Content-Length).
+	StartDownload(filename string, size int64)
+
+	// DownloadProgress is called as a download's bytes are received.
+	DownloadProgress(received, total int64)
+
+	// Finish is called once the operation completes, with a non-nil err if
+	// it failed.
+	Finish(err error)
+}
+
+// WithReporter sets a Reporter that receives progress events for
+// single-request multipart uploads, AsyncJob.Wait and WaitWebhook, and
+// DownloadFile. Chunked uploads (above the resumable threshold),
+// DownloadSplitDocument, DownloadSplitDocumentRange, and DownloadAll do not
+// report to it. Per-call OnUploadProgress callbacks still fire independently
+// of the reporter.
+func WithReporter(r Reporter) ClientOption {
+	return func(c *Client) {
+		c.reporter = r
+	}
+}
+
+// reporterOrNoop returns the client's configured Reporter, or a no-op one if
+// none was set via WithReporter.
+func (c *Client) reporterOrNoop() Reporter {
+	if c.reporter != nil {
+		return c.reporter
+	}
+	return noopReporter{}
+}
+
+// noopReporter discards all events; it's the client's default Reporter.
+type noopReporter struct{}
+
+func (noopReporter) StartUpload(filename string, size int64)   {}
+func (noopReporter) UploadProgress(sent, total int64)          {}
+func (noopReporter) JobProgress(status *JobStatusResponse)     {}
+func (noopReporter) StartDownload(filename string, size int64) {}
+func (noopReporter) DownloadProgress(received, total int64)    {}
+func (noopReporter) Finish(err error)                          {}
+
+// serializingReporter wraps a Reporter so that concurrent callers sharing
+// it (e.g. the workers in runConcurrentBatch) don't interleave their
+// Start*/Progress/Finish sequences: StartUpload and StartDownload block
+// until the previous operation's Finish, so the wrapped Reporter only ever
+// observes one operation in flight at a time. Most Reporter implementations,
+// including progress.TerminalReporter, assume exactly that.
+type serializingReporter struct {
+	mu   sync.Mutex
+	next Reporter
+}
+
+func (s *serializingReporter) StartUpload(filename string, size int64) {
+	s.mu.Lock()
+	s.next.StartUpload(filename, size)
+}
+
+func (s *serializingReporter) UploadProgress(sent, total int64) {
+	s.next.UploadProgress(sent, total)
+}
+
+func (s *serializingReporter) JobProgress(status *JobStatusResponse) {
+	s.next.JobProgress(status)
+}
+
+func (s *serializingReporter) StartDownload(filename string, size int64) {
+	s.mu.Lock()
+	s.next.StartDownload(filename, size)
+}
+
+func (s *serializingReporter) DownloadProgress(received, total int64) {
+	s.next.DownloadProgress(received, total)
+}
+
+func (s *serializingReporter) Finish(err error) {
+	s.next.Finish(err)
+	s.mu.Unlock()
+}
+
+// withSerializedReporter returns a shallow copy of c whose Reporter (if any)
+// is wrapped in a serializingReporter, for use by the concurrent batch APIs
+// so their workers don't stomp on each other's progress state. If c has no
+// Reporter configured, c is returned unchanged.
+func (c *Client) withSerializedReporter() *Client {
+	if c.reporter == nil {
+		return c
+	}
+	shadow := *c
+	shadow.reporter = &serializingReporter{next: c.reporter}
+	return &shadow
+}
@@ -0,0 +1,152 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingReporter records every event it receives, for assertions in
+// tests without depending on the progress subpackage's rendering.
+type recordingReporter struct {
+	mu       sync.Mutex
+	started  []string
+	uploads  []int64
+	jobs     []JobStatus
+	finishes []error
+}
+
+func (r *recordingReporter) StartUpload(filename string, size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, filename)
+}
+
+func (r *recordingReporter) UploadProgress(sent, total int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uploads = append(r.uploads, sent)
+}
+
+func (r *recordingReporter) JobProgress(status *JobStatusResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = append(r.jobs, status.Status)
+}
+
+func (r *recordingReporter) StartDownload(filename string, size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, filename)
+}
+
+func (r *recordingReporter) DownloadProgress(received, total int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uploads = append(r.uploads, received)
+}
+
+func (r *recordingReporter) Finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finishes = append(r.finishes, err)
+}
+
+func TestReporterUpload(t *testing.T) {
+	t.Run("reports StartUpload, UploadProgress, and Finish around a rename", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf"})
+		}))
+		defer server.Close()
+
+		reporter := &recordingReporter{}
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithReporter(reporter))
+
+		_, err := client.RenameReader(context.Background(), strings.NewReader("content"), "a.pdf", &RenameOptions{NoCache: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(reporter.started) != 1 || reporter.started[0] != "a.pdf" {
+			t.Errorf("expected StartUpload(\"a.pdf\", ...), got %v", reporter.started)
+		}
+		if len(reporter.uploads) == 0 {
+			t.Error("expected at least one UploadProgress call")
+		}
+		if len(reporter.finishes) != 1 || reporter.finishes[0] != nil {
+			t.Errorf("expected a single successful Finish call, got %v", reporter.finishes)
+		}
+	})
+}
+
+func TestReporterJobProgress(t *testing.T) {
+	t.Run("reports JobProgress for every poll and Finish on completion", func(t *testing.T) {
+		var calls int
+		mux := http.NewServeMux()
+		mux.HandleFunc("/status/job1", func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 2 {
+				json.NewEncoder(w).Encode(JobStatusResponse{JobID: "job1", Status: JobStatusProcessing, Progress: 50})
+				return
+			}
+			json.NewEncoder(w).Encode(JobStatusResponse{
+				JobID:  "job1",
+				Status: JobStatusCompleted,
+				Result: &PdfSplitResult{OriginalFilename: "big.pdf"},
+			})
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		reporter := &recordingReporter{}
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithReporter(reporter))
+
+		job := &AsyncJob{client: client, statusURL: "/status/job1", pollInterval: 1, maxAttempts: 10}
+		result, err := job.Wait(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.OriginalFilename != "big.pdf" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if len(reporter.jobs) != 2 {
+			t.Errorf("expected 2 JobProgress calls, got %d: %v", len(reporter.jobs), reporter.jobs)
+		}
+		if len(reporter.finishes) != 1 || reporter.finishes[0] != nil {
+			t.Errorf("expected a single successful Finish call, got %v", reporter.finishes)
+		}
+	})
+}
+
+func TestReporterDownload(t *testing.T) {
+	t.Run("reports StartDownload, DownloadProgress, and Finish", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("split document content"))
+		}))
+		defer server.Close()
+
+		reporter := &recordingReporter{}
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithReporter(reporter))
+
+		content, err := client.DownloadFile(context.Background(), server.URL+"/doc1.pdf")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(content) != "split document content" {
+			t.Errorf("unexpected content: %s", content)
+		}
+		if len(reporter.started) != 1 || reporter.started[0] != "doc1.pdf" {
+			t.Errorf("expected StartDownload(\"doc1.pdf\", ...), got %v", reporter.started)
+		}
+		if len(reporter.uploads) == 0 {
+			t.Error("expected at least one DownloadProgress call")
+		}
+		if len(reporter.finishes) != 1 || reporter.finishes[0] != nil {
+			t.Errorf("expected a single successful Finish call, got %v", reporter.finishes)
+		}
+	})
+}
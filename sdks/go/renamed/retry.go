@@ -0,0 +1,333 @@
+package renamed
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBackoffBase = 500 * time.Millisecond
+	defaultBackoffCap  = 30 * time.Second
+
+	defaultCircuitThreshold = 5
+	defaultCircuitWindow    = 30 * time.Second
+	defaultCircuitCooldown  = 30 * time.Second
+
+	// defaultRateLimitRetryWait is used when a 429 response has neither a
+	// parseable Retry-After header nor a retryAfter above zero (an explicit
+	// retryAfter of 0 gets this wait too), to avoid hammering the server in
+	// a tight loop.
+	defaultRateLimitRetryWait = 1 * time.Second
+)
+
+// OnRetryFunc is called before each retried attempt, including rate-limit
+// waits and backoff sleeps, so callers can log or emit metrics for retries.
+type OnRetryFunc func(attempt int, err error, sleep time.Duration)
+
+// WithBackoff sets the base and cap for the full-jitter exponential backoff
+// used on network errors and 5xx responses: sleep = rand(0, min(cap,
+// base*2^attempt)). Defaults to base=500ms, cap=30s.
+func WithBackoff(base, cap time.Duration) ClientOption {
+	return func(c *Client) {
+		c.backoffBase = base
+		c.backoffCap = cap
+	}
+}
+
+// WithRateLimit throttles outbound requests client-side to rps requests per
+// second, allowing bursts of up to burst requests, so a client stays under
+// its account's quota instead of relying on the server to reject overage.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = newTokenBucket(rps, burst)
+	}
+}
+
+// WithCircuitBreaker overrides the circuit breaker's defaults: it opens
+// after threshold consecutive upstream failures within window, fast-failing
+// with a CircuitOpenError until cooldown elapses and a half-open probe
+// succeeds. Defaults to threshold=5, window=30s, cooldown=30s.
+func WithCircuitBreaker(threshold int, window, cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		c.breaker = newCircuitBreaker(threshold, window, cooldown)
+	}
+}
+
+// WithOnRetry sets a hook invoked before each retried attempt, so callers
+// can log or emit metrics for retries.
+func WithOnRetry(fn OnRetryFunc) ClientOption {
+	return func(c *Client) {
+		c.onRetry = fn
+	}
+}
+
+// RetryPolicy overrides the client's default retry behavior: how long to
+// keep retrying a single request, the cap on any one attempt's backoff, and
+// which HTTP status codes are worth retrying at all.
+type RetryPolicy struct {
+	// MaxElapsed bounds the total wall-clock time doRequest spends retrying
+	// a single request, across all attempts. Zero means no cap.
+	MaxElapsed time.Duration
+
+	// PerAttemptCap overrides WithBackoff's cap for a single attempt's
+	// sleep. Zero means use the client's configured backoff cap.
+	PerAttemptCap time.Duration
+
+	// RetryableStatus overrides which HTTP status codes are retried.
+	// Defaults to 429 and 5xx responses when empty.
+	RetryableStatus []int
+}
+
+// WithRetryPolicy overrides which responses are retried, the backoff cap
+// per attempt, and the total time budget for retrying a single request,
+// independent of WithMaxRetries.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = &policy
+	}
+}
+
+func (c *Client) backoffBaseOrDefault() time.Duration {
+	if c.backoffBase > 0 {
+		return c.backoffBase
+	}
+	return defaultBackoffBase
+}
+
+func (c *Client) backoffCapOrDefault() time.Duration {
+	if c.retryPolicy != nil && c.retryPolicy.PerAttemptCap > 0 {
+		return c.retryPolicy.PerAttemptCap
+	}
+	if c.backoffCap > 0 {
+		return c.backoffCap
+	}
+	return defaultBackoffCap
+}
+
+// maxElapsedOrDefault returns the total time budget for retrying a single
+// request, or 0 if unbounded.
+func (c *Client) maxElapsedOrDefault() time.Duration {
+	if c.retryPolicy != nil {
+		return c.retryPolicy.MaxElapsed
+	}
+	return 0
+}
+
+// retryableStatus reports whether status should be retried, honoring
+// WithRetryPolicy's RetryableStatus override when set.
+func (c *Client) retryableStatus(status int) bool {
+	if c.retryPolicy != nil && len(c.retryPolicy.RetryableStatus) > 0 {
+		for _, s := range c.retryPolicy.RetryableStatus {
+			if s == status {
+				return true
+			}
+		}
+		return false
+	}
+	return status == http.StatusTooManyRequests || isRetryableStatus(status)
+}
+
+func (c *Client) notifyRetry(attempt int, err error, sleep time.Duration) {
+	if c.onRetry != nil {
+		c.onRetry(attempt, err, sleep)
+	}
+}
+
+// fullJitterBackoff returns a random duration in [0, min(cap, base*2^attempt)],
+// the "full jitter" strategy for spreading out retries after a failure.
+func fullJitterBackoff(base, cap time.Duration, attempt int) time.Duration {
+	max := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if max <= 0 || max > cap {
+		max = cap
+	}
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// sleepCtx sleeps for d, returning early with ctx.Err() if ctx is canceled
+// first.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// tokenBucket is a simple client-side token-bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleepCtx(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// circuitState is the state of a circuitBreaker.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker opens after threshold consecutive failures within window,
+// fast-failing callers until cooldown elapses and a single half-open probe
+// succeeds.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+
+	state      circuitState
+	failures   []time.Time
+	openedAt   time.Time
+	probeInUse bool
+}
+
+func newCircuitBreaker(threshold int, window, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, window: window, cooldown: cooldown}
+}
+
+// Allow reports whether a request may proceed, returning a CircuitOpenError
+// if the breaker is open and still cooling down.
+func (b *circuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		remaining := b.cooldown - time.Since(b.openedAt)
+		if remaining > 0 {
+			return NewCircuitOpenError(remaining)
+		}
+		b.state = circuitHalfOpen
+		b.probeInUse = true
+		return nil
+	case circuitHalfOpen:
+		if b.probeInUse {
+			return NewCircuitOpenError(0)
+		}
+		b.probeInUse = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// RecordSuccess closes the breaker, clearing any tracked failures.
+func (b *circuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = circuitClosed
+	b.failures = nil
+	b.probeInUse = false
+}
+
+// RecordFailure tracks a failure, opening the breaker if a half-open probe
+// failed or threshold failures occurred within window.
+func (b *circuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if b.state == circuitHalfOpen {
+		b.state = circuitOpen
+		b.openedAt = now
+		b.probeInUse = false
+		b.failures = nil
+		return
+	}
+
+	cutoff := now.Add(-b.window)
+	kept := b.failures[:0]
+	for _, f := range b.failures {
+		if f.After(cutoff) {
+			kept = append(kept, f)
+		}
+	}
+	b.failures = append(kept, now)
+
+	if len(b.failures) >= b.threshold {
+		b.state = circuitOpen
+		b.openedAt = now
+		b.failures = nil
+	}
+}
+
+// isRetryableStatus reports whether status is a server error worth retrying.
+func isRetryableStatus(status int) bool {
+	return status >= http.StatusInternalServerError
+}
+
+// parseRetryAfterHeader parses a Retry-After header, which per RFC 7231 is
+// either an integer number of seconds or an HTTP-date. It reports false if
+// the header is absent or doesn't parse as either form.
+func parseRetryAfterHeader(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,292 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetries5xxWithBackoff(t *testing.T) {
+	t.Run("retries up to maxRetries then succeeds", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			json.NewEncoder(w).Encode(User{ID: "user123"})
+		}))
+		defer server.Close()
+
+		var retries int32
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(3),
+			WithBackoff(time.Millisecond, 10*time.Millisecond),
+			WithOnRetry(func(attempt int, err error, sleep time.Duration) {
+				atomic.AddInt32(&retries, 1)
+			}),
+		)
+
+		user, err := client.GetUser(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.ID != "user123" {
+			t.Errorf("expected eventual success, got %+v", user)
+		}
+		if retries != 2 {
+			t.Errorf("expected 2 retries, got %d", retries)
+		}
+	})
+
+	t.Run("returns the 5xx error once maxRetries is exhausted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(1),
+			WithBackoff(time.Millisecond, 10*time.Millisecond),
+		)
+
+		if _, err := client.GetUser(context.Background()); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("opens after threshold consecutive failures and fast-fails", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(0),
+			WithBackoff(time.Millisecond, time.Millisecond),
+			WithCircuitBreaker(2, time.Minute, time.Minute),
+		)
+
+		for i := 0; i < 2; i++ {
+			if _, err := client.GetUser(context.Background()); err == nil {
+				t.Fatal("expected an error from the upstream 502")
+			}
+		}
+
+		_, err := client.GetUser(context.Background())
+		if _, ok := err.(*CircuitOpenError); !ok {
+			t.Errorf("expected CircuitOpenError once the breaker opens, got %T: %v", err, err)
+		}
+		if calls != 2 {
+			t.Errorf("expected the breaker to fast-fail without calling the server again, got %d calls", calls)
+		}
+	})
+
+	t.Run("opening mid-retry-loop fast-fails the remaining attempts", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(5),
+			WithBackoff(time.Millisecond, time.Millisecond),
+			WithCircuitBreaker(2, time.Minute, time.Minute),
+		)
+
+		_, err := client.GetUser(context.Background())
+		if _, ok := err.(*CircuitOpenError); !ok {
+			t.Errorf("expected the breaker to trip and fast-fail before maxRetries was exhausted, got %T: %v", err, err)
+		}
+		if calls != 2 {
+			t.Errorf("expected only 2 calls before the breaker opened mid-loop, got %d", calls)
+		}
+	})
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	t.Run("honors an integer-seconds Retry-After header on 429", func(t *testing.T) {
+		var calls int32
+		var gotSleep time.Duration
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				json.NewEncoder(w).Encode(map[string]any{"error": "rate limited"})
+				return
+			}
+			json.NewEncoder(w).Encode(User{ID: "user123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithOnRetry(func(attempt int, err error, sleep time.Duration) {
+				gotSleep = sleep
+			}),
+		)
+
+		if _, err := client.GetUser(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotSleep != 0 {
+			t.Errorf("expected the zero-second Retry-After header to be honored, got sleep %s", gotSleep)
+		}
+	})
+
+	t.Run("honors an HTTP-date Retry-After header on a 503", func(t *testing.T) {
+		var calls int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set("Retry-After", time.Now().Add(-time.Second).UTC().Format(http.TimeFormat))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			json.NewEncoder(w).Encode(User{ID: "user123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithMaxRetries(1))
+
+		if _, err := client.GetUser(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("expected one retry, got %d calls", calls)
+		}
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("limits which status codes are retried", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(3),
+			WithRetryPolicy(RetryPolicy{RetryableStatus: []int{http.StatusServiceUnavailable}}),
+		)
+
+		if _, err := client.GetUser(context.Background()); err == nil {
+			t.Error("expected an error, since 502 isn't in the configured RetryableStatus")
+		}
+		if calls != 1 {
+			t.Errorf("expected no retries for a status outside RetryableStatus, got %d calls", calls)
+		}
+	})
+
+	t.Run("gives up once MaxElapsed has passed", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithMaxRetries(100),
+			WithBackoff(time.Millisecond, time.Millisecond),
+			WithRetryPolicy(RetryPolicy{MaxElapsed: 20 * time.Millisecond}),
+		)
+
+		if _, err := client.GetUser(context.Background()); err == nil {
+			t.Error("expected an error once the elapsed budget is exhausted")
+		}
+		if calls < 2 {
+			t.Errorf("expected at least one retry before giving up, got %d calls", calls)
+		}
+	})
+}
+
+func TestNonRewindableBodySkipsRetry(t *testing.T) {
+	t.Run("does not retry a POST whose body can't be rewound", func(t *testing.T) {
+		var calls int32
+		var gotBody string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithMaxRetries(3), WithBackoff(time.Millisecond, time.Millisecond))
+
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/extract", io.NopCloser(strings.NewReader("payload")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req = req.WithContext(context.Background())
+
+		resp, err := client.doRequest(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadGateway {
+			t.Errorf("expected the single 502 response to be returned as-is, got %d", resp.StatusCode)
+		}
+		if calls != 1 {
+			t.Errorf("expected no retries for a non-rewindable body, got %d calls", calls)
+		}
+		if gotBody != "payload" {
+			t.Errorf("expected the single attempt to see the original body, got %q", gotBody)
+		}
+	})
+}
+
+func TestTokenBucket(t *testing.T) {
+	t.Run("throttles to the configured rate", func(t *testing.T) {
+		b := newTokenBucket(1000, 1)
+		ctx := context.Background()
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			if err := b.Wait(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed < time.Millisecond {
+			t.Errorf("expected waiting for tokens to take some time, took %s", elapsed)
+		}
+	})
+
+	t.Run("respects context cancellation while waiting", func(t *testing.T) {
+		b := newTokenBucket(0.001, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// First call consumes the only token instantly.
+		if err := b.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := b.Wait(ctx); err == nil {
+			t.Error("expected context deadline to interrupt the wait")
+		}
+	})
+}
@@ -0,0 +1,210 @@
+package renamed
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyTransport fails the first failures RoundTrips with a network error,
+// then delegates to next. It's used to exercise doRequest's retry path
+// against a streamed multipart upload.
+type flakyTransport struct {
+	failures int32
+	calls    int32
+	next     http.RoundTripper
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if atomic.AddInt32(&f.calls, 1) <= f.failures {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return f.next.RoundTrip(req)
+}
+
+func TestUploadFileStreaming(t *testing.T) {
+	t.Run("reports incremental progress as the multipart body streams", func(t *testing.T) {
+		const content = "fake pdf content spanning several reads"
+		var gotFilename string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reader, err := r.MultipartReader()
+			if err != nil {
+				t.Fatalf("expected a multipart request: %v", err)
+			}
+			for {
+				part, err := reader.NextPart()
+				if err != nil {
+					break
+				}
+				if part.FormName() == "file" {
+					gotFilename = part.FileName()
+				}
+			}
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf", Confidence: 0.9})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		var progressed []int64
+		result, err := client.RenameReader(context.Background(), strings.NewReader(content), "a.pdf", &RenameOptions{
+			NoCache: true,
+			OnUploadProgress: func(sent, total int64) {
+				progressed = append(progressed, sent)
+				if total != int64(len(content)) {
+					t.Errorf("expected total %d, got %d", len(content), total)
+				}
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.SuggestedFilename != "invoice.pdf" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if gotFilename != "a.pdf" {
+			t.Errorf("expected filename a.pdf, got %s", gotFilename)
+		}
+		if len(progressed) == 0 {
+			t.Error("expected at least one progress callback")
+		}
+		if progressed[len(progressed)-1] != int64(len(content)) {
+			t.Errorf("expected final progress to equal content length %d, got %d", len(content), progressed[len(progressed)-1])
+		}
+	})
+
+	t.Run("falls back to the client-wide progress callback", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf"})
+		}))
+		defer server.Close()
+
+		var calls int
+		client := NewClient("rt_test123", WithBaseURL(server.URL), WithUploadProgress(func(sent, total int64) {
+			calls++
+		}))
+
+		_, err := client.RenameReader(context.Background(), strings.NewReader("content"), "a.pdf", &RenameOptions{NoCache: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls == 0 {
+			t.Error("expected the client-wide upload progress callback to fire")
+		}
+	})
+}
+
+func TestRenameFile(t *testing.T) {
+	t.Run("streams content from disk without reading it fully into memory first", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "invoice.pdf")
+		if err := os.WriteFile(path, []byte("fake pdf content"), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mr, err := r.MultipartReader()
+			if err != nil {
+				t.Fatalf("expected a multipart request: %v", err)
+			}
+			var gotBody []byte
+			for {
+				part, perr := mr.NextPart()
+				if perr != nil {
+					break
+				}
+				if part.FormName() == "file" {
+					gotBody = readAllPart(t, part)
+				}
+			}
+			if string(gotBody) != "fake pdf content" {
+				t.Errorf("unexpected uploaded content: %s", gotBody)
+			}
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf", Confidence: 0.95})
+		}))
+		defer server.Close()
+
+		client := NewClient("rt_test123", WithBaseURL(server.URL))
+
+		result, err := client.RenameFile(context.Background(), path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.SuggestedFilename != "invoice.pdf" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("retries a transient network error by re-reading the body from GetBody", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "invoice.pdf")
+		if err := os.WriteFile(path, []byte("fake pdf content"), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		var gotBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mr, err := r.MultipartReader()
+			if err != nil {
+				t.Fatalf("expected a multipart request: %v", err)
+			}
+			for {
+				part, perr := mr.NextPart()
+				if perr != nil {
+					break
+				}
+				if part.FormName() == "file" {
+					gotBody = readAllPart(t, part)
+				}
+			}
+			json.NewEncoder(w).Encode(RenameResult{SuggestedFilename: "invoice.pdf"})
+		}))
+		defer server.Close()
+
+		transport := &flakyTransport{failures: 1, next: http.DefaultTransport}
+		client := NewClient("rt_test123",
+			WithBaseURL(server.URL),
+			WithHTTPClient(&http.Client{Transport: transport}),
+			WithMaxRetries(3),
+			WithBackoff(time.Millisecond, time.Millisecond),
+		)
+
+		result, err := client.RenameFile(context.Background(), path, nil)
+		if err != nil {
+			t.Fatalf("expected the upload to succeed after retrying the transient failure, got: %v", err)
+		}
+		if result.SuggestedFilename != "invoice.pdf" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if string(gotBody) != "fake pdf content" {
+			t.Errorf("unexpected uploaded content on the retried attempt: %s", gotBody)
+		}
+		if transport.calls != 2 {
+			t.Errorf("expected exactly 2 attempts (1 failure then 1 success), got %d", transport.calls)
+		}
+	})
+}
+
+func readAllPart(t *testing.T, part *multipart.Part) []byte {
+	t.Helper()
+	buf := make([]byte, 0, 64)
+	tmp := make([]byte, 16)
+	for {
+		n, err := part.Read(tmp)
+		buf = append(buf, tmp[:n]...)
+		if err != nil {
+			break
+		}
+	}
+	return buf
+}
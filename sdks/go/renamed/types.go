@@ -19,6 +19,15 @@ type RenameResult struct {
 type RenameOptions struct {
 	// Template is a custom template for filename generation.
 	Template string
+
+	// OnUploadProgress is called as the file upload progresses. For files
+	// larger than the client's resumable threshold, this reflects chunked
+	// upload progress rather than a single multipart request.
+	OnUploadProgress UploadProgress
+
+	// NoCache bypasses the client's cache for this call, even if a Cache is
+	// configured.
+	NoCache bool
 }
 
 // SplitMode is the mode for PDF splitting.
@@ -42,6 +51,22 @@ type PdfSplitOptions struct {
 
 	// PagesPerSplit is the number of pages per split (for pages mode).
 	PagesPerSplit int
+
+	// OnUploadProgress is called as the file upload progresses. For files
+	// larger than the client's resumable threshold, this reflects chunked
+	// upload progress rather than a single multipart request.
+	OnUploadProgress UploadProgress
+
+	// WebhookURL, if set, overrides the client's configured webhook URL
+	// (see WithWebhook) for this split only, so the server delivers
+	// completion to it instead of requiring AsyncJob.Wait to poll. Use
+	// WebhookSecret to sign those deliveries; verify them with the
+	// renamed/webhook package and feed the result into AsyncJob.WaitWebhook.
+	WebhookURL string
+
+	// WebhookSecret signs deliveries to WebhookURL. Ignored if WebhookURL
+	// is empty.
+	WebhookSecret string
 }
 
 // JobStatus is the status of an async job.
@@ -109,6 +134,13 @@ type ExtractOptions struct {
 
 	// Prompt is a natural language description of what to extract.
 	Prompt string
+
+	// OnUploadProgress is called as the file upload progresses.
+	OnUploadProgress UploadProgress
+
+	// NoCache bypasses the client's cache for this call, even if a Cache is
+	// configured.
+	NoCache bool
 }
 
 // ExtractResult is the result of extract operation.
@@ -116,8 +148,12 @@ type ExtractResult struct {
 	// Data is the extracted data matching the schema.
 	Data map[string]any `json:"data"`
 
-	// Confidence is the confidence score (0-1).
+	// Confidence is the overall confidence score (0-1).
 	Confidence float64 `json:"confidence"`
+
+	// FieldConfidence is the per-field confidence score (0-1), keyed by the
+	// same field names used in Data, when the server provides it.
+	FieldConfidence map[string]float64 `json:"fieldConfidence,omitempty"`
 }
 
 // Team is team information.
@@ -149,9 +185,17 @@ type User struct {
 
 // pdfSplitResponse is the initial response from pdf-split endpoint.
 type pdfSplitResponse struct {
+	JobID     string `json:"jobId"`
 	StatusURL string `json:"statusUrl"`
 }
 
+// registerWebhookRequest is the body sent to register a webhook for a job
+// already in flight.
+type registerWebhookRequest struct {
+	WebhookURL    string `json:"webhookUrl"`
+	WebhookSecret string `json:"webhookSecret"`
+}
+
 // MIME types for supported file formats.
 var mimeTypes = map[string]string{
 	".pdf":  "application/pdf",
@@ -0,0 +1,175 @@
+// Package webhook verifies and decodes renamed.to webhook deliveries for
+// async jobs such as pdf-split.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renamed-to/renamed-sdk/sdks/go/renamed"
+)
+
+// DefaultMaxSkew is the default maximum allowed difference between a
+// webhook's signed timestamp and the current time.
+const DefaultMaxSkew = 5 * time.Minute
+
+// ErrInvalidSignature indicates the X-Renamed-Signature header was missing,
+// malformed, or did not match the computed HMAC.
+var ErrInvalidSignature = errors.New("renamed/webhook: invalid signature")
+
+// ErrTimestampSkew indicates the signed timestamp was too far from now,
+// which could indicate a replayed request.
+var ErrTimestampSkew = errors.New("renamed/webhook: timestamp outside allowed skew")
+
+// VerifyOption configures Verify and Handler.
+type VerifyOption func(*verifyConfig)
+
+type verifyConfig struct {
+	maxSkew time.Duration
+}
+
+// WithMaxSkew overrides the default 5-minute replay window used to validate
+// a webhook's signed timestamp.
+func WithMaxSkew(d time.Duration) VerifyOption {
+	return func(c *verifyConfig) {
+		c.maxSkew = d
+	}
+}
+
+// Verify validates the X-Renamed-Signature header on r against secret and
+// returns the raw request body.
+//
+// Two header formats are accepted:
+//
+//   - The Stripe-style "t=<unix-seconds>,v1=<hex-hmac-sha256>", signing the
+//     payload "<timestamp>.<body>". This is what the server currently sends.
+//   - "X-Renamed-Signature: sha256=<hex-hmac-sha256>" paired with a separate
+//     "X-Renamed-Timestamp: <unix-seconds>" header, signing the same
+//     payload. Some early integration docs described this format; it's
+//     accepted here too so webhook consumers built against either version
+//     of the docs work unmodified.
+//
+// Timestamps more than the configured skew (DefaultMaxSkew unless
+// overridden with WithMaxSkew) from now are rejected to prevent replay.
+func Verify(r *http.Request, secret string, opts ...VerifyOption) ([]byte, error) {
+	cfg := verifyConfig{maxSkew: DefaultMaxSkew}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	header := r.Header.Get("X-Renamed-Signature")
+	if header == "" {
+		return nil, ErrInvalidSignature
+	}
+
+	ts, sig, err := parseSignatureHeader(header, r.Header.Get("X-Renamed-Timestamp"))
+	if err != nil {
+		return nil, err
+	}
+
+	if abs(time.Since(time.Unix(ts, 0))) > cfg.maxSkew {
+		return nil, ErrTimestampSkew
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	got, err := hex.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, expected) {
+		return nil, ErrInvalidSignature
+	}
+
+	return body, nil
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// parseSignatureHeader parses either "t=<seconds>,v1=<hex>" or, if header
+// has a "sha256=" prefix, the hex signature alone, reading its timestamp
+// from timestampHeader instead.
+func parseSignatureHeader(header, timestampHeader string) (ts int64, sig string, err error) {
+	if rest := strings.TrimPrefix(header, "sha256="); rest != header {
+		if timestampHeader == "" {
+			return 0, "", ErrInvalidSignature
+		}
+		ts, err = strconv.ParseInt(timestampHeader, 10, 64)
+		if err != nil {
+			return 0, "", ErrInvalidSignature
+		}
+		return ts, rest, nil
+	}
+
+	var tsStr string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			tsStr = kv[1]
+		case "v1":
+			sig = kv[1]
+		}
+	}
+	if tsStr == "" || sig == "" {
+		return 0, "", ErrInvalidSignature
+	}
+
+	ts, err = strconv.ParseInt(tsStr, 10, 64)
+	if err != nil {
+		return 0, "", ErrInvalidSignature
+	}
+
+	return ts, sig, nil
+}
+
+// Handler returns an http.Handler that verifies each request against secret,
+// decodes the body into a renamed.JobStatusResponse, and invokes on. It
+// responds 401 on an invalid signature, 400 on an undecodable payload, and
+// 500 if on returns an error; otherwise it responds 200.
+func Handler(secret string, on func(context.Context, *renamed.JobStatusResponse) error, opts ...VerifyOption) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := Verify(r, secret, opts...)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		var status renamed.JobStatusResponse
+		if err := json.Unmarshal(body, &status); err != nil {
+			http.Error(w, fmt.Sprintf("renamed/webhook: invalid payload: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if err := on(r.Context(), &status); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
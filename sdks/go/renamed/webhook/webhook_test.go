@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/renamed-to/renamed-sdk/sdks/go/renamed"
+)
+
+func sign(secret string, ts int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSignedRequest(secret string, ts int64, body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set("X-Renamed-Signature", fmt.Sprintf("t=%d,v1=%s", ts, sign(secret, ts, body)))
+	return req
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("accepts a validly signed request", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1","status":"completed"}`)
+		req := newSignedRequest("whsec_test", time.Now().Unix(), body)
+
+		got, err := Verify(req, "whsec_test")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, body) {
+			t.Errorf("expected body %s, got %s", body, got)
+		}
+	})
+
+	t.Run("rejects a missing signature header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader([]byte("{}")))
+
+		if _, err := Verify(req, "whsec_test"); err != ErrInvalidSignature {
+			t.Errorf("expected ErrInvalidSignature, got %v", err)
+		}
+	})
+
+	t.Run("rejects a signature from the wrong secret", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1"}`)
+		req := newSignedRequest("whsec_other", time.Now().Unix(), body)
+
+		if _, err := Verify(req, "whsec_test"); err != ErrInvalidSignature {
+			t.Errorf("expected ErrInvalidSignature, got %v", err)
+		}
+	})
+
+	t.Run("rejects a timestamp outside the default skew", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1"}`)
+		req := newSignedRequest("whsec_test", time.Now().Add(-10*time.Minute).Unix(), body)
+
+		if _, err := Verify(req, "whsec_test"); err != ErrTimestampSkew {
+			t.Errorf("expected ErrTimestampSkew, got %v", err)
+		}
+	})
+
+	t.Run("WithMaxSkew widens the replay window", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1"}`)
+		req := newSignedRequest("whsec_test", time.Now().Add(-10*time.Minute).Unix(), body)
+
+		if _, err := Verify(req, "whsec_test", WithMaxSkew(15*time.Minute)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("accepts the sha256=/X-Renamed-Timestamp header pair", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1","status":"completed"}`)
+		ts := time.Now().Unix()
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set("X-Renamed-Signature", "sha256="+sign("whsec_test", ts, body))
+		req.Header.Set("X-Renamed-Timestamp", strconv.FormatInt(ts, 10))
+
+		got, err := Verify(req, "whsec_test")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, body) {
+			t.Errorf("expected body %s, got %s", body, got)
+		}
+	})
+
+	t.Run("rejects sha256= signature missing X-Renamed-Timestamp", func(t *testing.T) {
+		body := []byte(`{"jobId":"job_1"}`)
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set("X-Renamed-Signature", "sha256="+sign("whsec_test", time.Now().Unix(), body))
+
+		if _, err := Verify(req, "whsec_test"); err != ErrInvalidSignature {
+			t.Errorf("expected ErrInvalidSignature, got %v", err)
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("decodes the payload and invokes on", func(t *testing.T) {
+		body, _ := json.Marshal(renamed.JobStatusResponse{JobID: "job_1", Status: renamed.JobStatusCompleted})
+		req := newSignedRequest("whsec_test", time.Now().Unix(), body)
+
+		var got *renamed.JobStatusResponse
+		h := Handler("whsec_test", func(ctx context.Context, status *renamed.JobStatusResponse) error {
+			got = status
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if got == nil || got.JobID != "job_1" {
+			t.Errorf("expected on to receive job_1, got %+v", got)
+		}
+	})
+
+	t.Run("responds 401 on an invalid signature", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader([]byte("{}")))
+
+		h := Handler("whsec_test", func(ctx context.Context, status *renamed.JobStatusResponse) error {
+			t.Error("on should not be called")
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected 401, got %d", w.Code)
+		}
+	})
+
+	t.Run("responds 500 when on returns an error", func(t *testing.T) {
+		body, _ := json.Marshal(renamed.JobStatusResponse{JobID: "job_1"})
+		req := newSignedRequest("whsec_test", time.Now().Unix(), body)
+
+		h := Handler("whsec_test", func(ctx context.Context, status *renamed.JobStatusResponse) error {
+			return fmt.Errorf("boom")
+		})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected 500, got %d", w.Code)
+		}
+	})
+}